@@ -30,19 +30,33 @@ func GenerateAccount(name string) (sdkAccounts.Account, error) {
 }
 
 // PerformGenerateAccount - wrapper around sdkAccounts.GenerateAccount
+// Accounts are derived from the configured seed instead of using random keys when framework.seed has been set
 func PerformGenerateAccount(name string, attempts int) (sdkAccounts.Account, error) {
-	account, err := sdkAccounts.GenerateAccount(name, config.Configuration.Account.Passphrase)
+	generate := func() (sdkAccounts.Account, error) {
+		return sdkAccounts.GenerateAccount(name, config.Configuration.Account.Passphrase)
+	}
+	if config.Configuration.Framework.Seed != "" {
+		generate = func() (sdkAccounts.Account, error) {
+			return GenerateDerivedAccount(name)
+		}
+	}
+
+	account, err := generate()
 
 	for {
 		if (err != nil || account.Name == "" || account.Address == "") && attempts > 0 {
 			goSdkAccount.RemoveAccount(name)
-			account, err = sdkAccounts.GenerateAccount(name, config.Configuration.Account.Passphrase)
+			account, err = generate()
 			attempts--
 		} else {
 			break
 		}
 	}
 
+	if err == nil && account.Address != "" {
+		Track(account)
+	}
+
 	return account, err
 }
 
@@ -56,6 +70,17 @@ func ImportKeystoreAccount(keyFile string, keyName string, address string) (sdkA
 	return sdkAccounts.ImportKeystoreAccount(keyFile, keyName, address, config.Configuration.Account.Passphrase)
 }
 
+// FindAccountByAddress - looks up a keystore account using its address
+func FindAccountByAddress(address string) (sdkAccounts.Account, error) {
+	account := sdkAccounts.FindAccountByAddress(address)
+	if account.Name == "" {
+		return account, fmt.Errorf("can't find an account with the address %s in the keystore - please make sure its key has been imported", address)
+	}
+	account.Passphrase = config.Configuration.Account.Passphrase
+
+	return account, nil
+}
+
 // GenerateMultipleAccounts - generates multiple typed accounts
 func GenerateMultipleAccounts(nameTemplate string, count int64) (accs []sdkAccounts.Account) {
 	for i := int64(0); i < count; i++ {
@@ -0,0 +1,81 @@
+package accounts
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	goSdkAccount "github.com/harmony-one/go-sdk/pkg/account"
+	"github.com/harmony-one/go-sdk/pkg/address"
+	goSdkKeys "github.com/harmony-one/go-sdk/pkg/keys"
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+// DerivedKey - a private key derived from the configured seed
+type DerivedKey struct {
+	Name       string
+	Address    string
+	PrivateKey string
+	Path       string
+}
+
+// DeriveKey - derives the key of a named account from a seed and a run
+// The account name already contains the test case and the role of the account (e.g. HarmonyTF_Testnet_TestCase_TX-1_Sender) which gives every account its own derivation path:
+// 44'/1023'/0'/0/<index> where the index is derived from sha256(run/name)
+func DeriveKey(seed string, run string, name string) (DerivedKey, error) {
+	if seed == "" {
+		return DerivedKey{}, fmt.Errorf("no seed has been configured - use framework.seed or --seed")
+	}
+
+	index := derivationIndex(run, name)
+	privateKey, _ := goSdkKeys.FromMnemonicSeedAndPassphrase(seed, index)
+	if privateKey == nil {
+		return DerivedKey{}, fmt.Errorf("failed to derive the key for %s", name)
+	}
+
+	ecdsaKey := privateKey.ToECDSA()
+
+	return DerivedKey{
+		Name:       name,
+		Address:    address.ToBech32(crypto.PubkeyToAddress(ecdsaKey.PublicKey)),
+		PrivateKey: hex.EncodeToString(crypto.FromECDSA(ecdsaKey)),
+		Path:       fmt.Sprintf("44'/1023'/0'/0/%d", index),
+	}, nil
+}
+
+// GenerateDerivedAccount - derives the key of a named account from the configured seed and run and imports it into the keystore
+func GenerateDerivedAccount(name string) (sdkAccounts.Account, error) {
+	key, err := DeriveKey(config.Configuration.Framework.Seed, config.Configuration.Framework.Run, name)
+	if err != nil {
+		return sdkAccounts.Account{}, err
+	}
+
+	return ImportDerivedKey(key)
+}
+
+// ImportDerivedKey - imports a derived key into the keystore, replacing any stale account using the same name
+func ImportDerivedKey(key DerivedKey) (sdkAccounts.Account, error) {
+	if sdkAccounts.DoesNamedAccountExist(key.Name) && sdkAccounts.FindAccountAddressByName(key.Name) != key.Address {
+		goSdkAccount.RemoveAccount(key.Name)
+	}
+
+	account, err := sdkAccounts.ImportPrivateKeyAccount(key.PrivateKey, key.Name, key.Address, config.Configuration.Account.Passphrase)
+	if err != nil {
+		return sdkAccounts.Account{}, err
+	}
+
+	if account.Address == "" {
+		return sdkAccounts.Account{}, fmt.Errorf("failed to import the derived account %s", key.Name)
+	}
+
+	return account, nil
+}
+
+// derivationIndex - non-hardened BIP32 indexes are limited to 31 bits
+func derivationIndex(run string, name string) int {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", run, name)))
+	return int(binary.BigEndian.Uint32(hash[:4]) & 0x7fffffff)
+}
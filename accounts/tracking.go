@@ -0,0 +1,46 @@
+package accounts
+
+import (
+	"sync"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+)
+
+var (
+	trackedMutex    sync.Mutex
+	trackedAccounts = make(map[string]sdkAccounts.Account)
+	trackedOrder    []string
+)
+
+// Track - keeps track of a generated account so that it can be swept during the final teardown
+func Track(account sdkAccounts.Account) {
+	trackedMutex.Lock()
+	defer trackedMutex.Unlock()
+
+	if _, ok := trackedAccounts[account.Address]; !ok {
+		trackedOrder = append(trackedOrder, account.Address)
+	}
+	trackedAccounts[account.Address] = account
+}
+
+// Untrack - stops keeping track of an account, e.g. when it's meant to outlive the run
+func Untrack(address string) {
+	trackedMutex.Lock()
+	defer trackedMutex.Unlock()
+
+	delete(trackedAccounts, address)
+}
+
+// Tracked - returns all tracked accounts in the order they were generated
+func Tracked() (accs []sdkAccounts.Account) {
+	trackedMutex.Lock()
+	defer trackedMutex.Unlock()
+
+	for _, address := range trackedOrder {
+		if account, ok := trackedAccounts[address]; ok {
+			accs = append(accs, account)
+		}
+	}
+
+	return accs
+}
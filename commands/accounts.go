@@ -0,0 +1,303 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	goSDKCommon "github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/go-sdk/pkg/store"
+	"github.com/harmony-one/harmony-tf/balances"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/keys"
+	"github.com/harmony-one/harmony/numeric"
+	"github.com/spf13/cobra"
+)
+
+// AccountsArguments - represents the arguments for the accounts commands
+type AccountsArguments struct {
+	Funded      bool
+	Amount      string
+	FromShardID uint32
+	ToShardID   uint32
+}
+
+var accountsArgs AccountsArguments
+
+func init() {
+	accountsCommand := &cobra.Command{
+		Use:   "accounts",
+		Short: "Inspect and move the funds of the framework's own accounts on the current network",
+	}
+
+	listCommand := &cobra.Command{
+		Use:   "list",
+		Short: "List the funding account, funding pool, source and leftover generated accounts including their per shard balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listAccounts()
+		},
+	}
+	listCommand.Flags().BoolVar(&accountsArgs.Funded, "funded", false, "--funded - only list accounts holding funds")
+	accountsCommand.AddCommand(listCommand)
+
+	accountsCommand.AddCommand(&cobra.Command{
+		Use:   "balance <address|name>",
+		Short: "Show the balances of an account in all shards",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showAccountBalance(args[0])
+		},
+	})
+
+	transferCommand := &cobra.Command{
+		Use:   "transfer <from address|name> <to address|name>",
+		Short: "Transfer funds from a keystore account using the funding gas settings and retries",
+		Long:  "Transfer funds from an account in the keystore (e.g. the funding account or a leftover test account) or one of the source keys (imported into the keystore on demand) using the funding gas limit, gas price, timeout and retry attempts - the gas price is bumped automatically if the tx is underpriced",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return transferFunds(args[0], args[1])
+		},
+	}
+	transferCommand.Flags().StringVar(&accountsArgs.Amount, "amount", "", "--amount <amount>")
+	transferCommand.Flags().Uint32Var(&accountsArgs.FromShardID, "from-shard", 0, "--from-shard <shard id>")
+	transferCommand.Flags().Uint32Var(&accountsArgs.ToShardID, "to-shard", 0, "--to-shard <shard id>")
+	accountsCommand.AddCommand(transferCommand)
+
+	config.RootCommand.AddCommand(accountsCommand)
+}
+
+func listAccounts() error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	// ListKeys doesn't import, filter or quarantine any keys, listing accounts shouldn't change the key setup
+	sourceKeys, err := keys.ListKeys()
+	if err != nil {
+		return err
+	}
+
+	sources := make(map[string]keys.KeyDetails)
+	for _, key := range sourceKeys {
+		sources[key.Address] = key
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"Name", "Address", "Role"}
+	for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+		header = append(header, fmt.Sprintf("Shard %d", shardID))
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, account := range frameworkAccounts(sourceKeys) {
+		row := []string{account.Name, account.Address, accountRole(account, sources)}
+
+		shardBalances, total := accountBalances(account.Address)
+		if accountsArgs.Funded && !total.IsPositive() {
+			continue
+		}
+
+		fmt.Fprintln(writer, strings.Join(append(row, shardBalances...), "\t"))
+	}
+
+	return writer.Flush()
+}
+
+// frameworkAccounts - the accounts of the current network, i.e. the source keys (whether or not they've been imported yet) and the accounts generated by the framework
+func frameworkAccounts(sourceKeys []keys.KeyDetails) (accs []sdkAccounts.Account) {
+	generatedPrefix := fmt.Sprintf("%s_%s_", config.Configuration.Framework.Identifier, strings.Title(config.Configuration.Network.Name))
+	listed := make(map[string]bool)
+
+	names := store.LocalAccounts()
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, generatedPrefix) {
+			continue
+		}
+
+		if address := sdkAccounts.FindAccountAddressByName(name); address != "" {
+			accs = append(accs, sdkAccounts.Account{Name: name, Address: address})
+			listed[address] = true
+		}
+	}
+
+	for _, key := range sourceKeys {
+		if listed[key.Address] {
+			continue
+		}
+
+		accs = append(accs, sdkAccounts.Account{Name: sdkAccounts.FindAccountNameByAddress(key.Address), Address: key.Address})
+		listed[key.Address] = true
+	}
+
+	return accs
+}
+
+func accountRole(account sdkAccounts.Account, sources map[string]keys.KeyDetails) string {
+	source, isSource := sources[account.Address]
+
+	switch {
+	case account.Name != "" && account.Name == config.Configuration.Funding.Account.Name:
+		return "funding"
+	case strings.HasPrefix(account.Name, fmt.Sprintf("%s_Pool_", config.Configuration.Funding.Account.Name)):
+		return "pool"
+	case isSource && source.Quarantined:
+		return "source (quarantined)"
+	case isSource:
+		return "source"
+	default:
+		return "generated"
+	}
+}
+
+// accountBalances - the formatted balances of an address in every shard and its total balance
+func accountBalances(address string) ([]string, numeric.Dec) {
+	total := numeric.NewDec(0)
+	shardBalances := []string{}
+
+	for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+		balance, err := balances.GetShardBalance(address, uint32(shardID))
+		if err != nil || balance.IsNil() {
+			shardBalances = append(shardBalances, "n/a")
+			continue
+		}
+		total = total.Add(balance)
+		shardBalances = append(shardBalances, balance.String())
+	}
+
+	return shardBalances, total
+}
+
+func showAccountBalance(target string) error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	account, err := resolveAccount(target)
+	if err != nil {
+		return err
+	}
+
+	shardBalances, total := accountBalances(account.Address)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if account.Name != "" {
+		fmt.Fprintf(writer, "Name\t%s\n", account.Name)
+	}
+	fmt.Fprintf(writer, "Address\t%s\n", account.Address)
+	for shardID, balance := range shardBalances {
+		fmt.Fprintf(writer, "Shard %d\t%s\n", shardID, balance)
+	}
+	fmt.Fprintf(writer, "Total\t%s\n", total.String())
+
+	return writer.Flush()
+}
+
+func transferFunds(from string, to string) error {
+	if accountsArgs.Amount == "" {
+		return errors.New("an amount is required - supply it using --amount")
+	}
+
+	amount, err := goSDKCommon.NewDecFromString(accountsArgs.Amount)
+	if err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return fmt.Errorf("the amount has to be positive, got %s", accountsArgs.Amount)
+	}
+
+	if err := configure(); err != nil {
+		return err
+	}
+
+	shards := uint32(config.Configuration.Network.Shards)
+	if accountsArgs.FromShardID >= shards || accountsArgs.ToShardID >= shards {
+		return fmt.Errorf("%s only has %d shard(s)", config.Configuration.Network.Name, shards)
+	}
+
+	sender, err := resolveAccount(from)
+	if err != nil || sender.Name == "" {
+		// The source keys (private keys, key sources and keystore files) only get imported when the test suite runs - import them in case the sender is one of them
+		if _, err := keys.LoadKeys(); err != nil {
+			return err
+		}
+
+		if sender, err = resolveAccount(from); err != nil {
+			return err
+		}
+	}
+
+	if sender.Name == "" {
+		return fmt.Errorf("couldn't find %s in the keystore or the source keys - only keystore accounts can be used to send funds", from)
+	}
+
+	receiver, err := resolveAccount(to)
+	if err != nil {
+		return err
+	}
+
+	if sender.Address == receiver.Address && accountsArgs.FromShardID == accountsArgs.ToShardID {
+		return errors.New("the sender and the receiver are the same account in the same shard")
+	}
+
+	balance, err := balances.GetShardBalance(receiver.Address, accountsArgs.ToShardID)
+	if err != nil {
+		return err
+	}
+
+	if balance.IsNil() {
+		balance = numeric.NewDec(0)
+	}
+
+	sender.Passphrase = config.Configuration.Account.Passphrase
+	if err := sender.Unlock(); err != nil {
+		return err
+	}
+
+	fmt.Println(fmt.Sprintf("Transferring %f from %s (shard: %d) to %s (shard: %d)", amount, sender.Address, accountsArgs.FromShardID, receiver.Address, accountsArgs.ToShardID))
+
+	if err := funding.PerformFundingTransaction(
+		&sender,
+		accountsArgs.FromShardID,
+		receiver.Address,
+		accountsArgs.ToShardID,
+		amount,
+		-1,
+		config.Configuration.Funding.Gas.Limit,
+		config.Configuration.Funding.Gas.Price,
+		timeout(),
+		config.Configuration.Funding.Retry.Attempts,
+	); err != nil {
+		return err
+	}
+
+	// PerformFundingTransaction gives up silently once it runs out of attempts, so verify that the funds actually arrived
+	balance, err = balances.GetExpectedShardBalance(receiver.Address, accountsArgs.ToShardID, balance.Add(amount))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(fmt.Sprintf("Successfully transferred %f - the balance of %s in shard %d is now %f", amount, receiver.Address, accountsArgs.ToShardID, balance))
+
+	return nil
+}
+
+// resolveAccount - resolves an address or a keystore account name, the name is only set for accounts in the keystore
+func resolveAccount(target string) (sdkAccounts.Account, error) {
+	if strings.HasPrefix(target, "one1") {
+		return sdkAccounts.Account{Name: sdkAccounts.FindAccountNameByAddress(target), Address: target}, nil
+	}
+
+	if address := sdkAccounts.FindAccountAddressByName(target); address != "" {
+		return sdkAccounts.Account{Name: target, Address: address}, nil
+	}
+
+	return sdkAccounts.Account{}, fmt.Errorf("couldn't find an account named %s in the keystore", target)
+}
@@ -0,0 +1,36 @@
+// Package commands contains the subcommands of the Harmony TF cli
+// Every subcommand registers itself on config.RootCommand - the package is imported by main.go to make them available
+package commands
+
+import (
+	"path/filepath"
+
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+// configure - configures the framework using the base path specified by --path
+func configure() error {
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := config.Configure(basePath); err != nil {
+		return err
+	}
+
+	if config.Args.Node != "" {
+		config.Configuration.Network.API.Node = config.Args.Node
+	}
+
+	return nil
+}
+
+// timeout - the tx timeout subcommands should use
+func timeout() int {
+	if config.Configuration.Network.Timeout > 0 {
+		return config.Configuration.Network.Timeout
+	}
+
+	return config.Configuration.Funding.Timeout
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+func init() {
+	configCommand := &cobra.Command{
+		Use:   "config",
+		Short: "Configuration tooling",
+	}
+
+	configCommand.AddCommand(&cobra.Command{
+		Use:   "show",
+		Short: "Show the effective configuration",
+		Long:  "Show the effective configuration after applying config.yml, the network overlay (config.<network>.yml), HTF_ env variables and --set/dedicated flags - secrets are redacted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showConfig()
+		},
+	})
+
+	config.RootCommand.AddCommand(configCommand)
+}
+
+func showConfig() error {
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := config.LoadLayeredConfig(basePath); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(config.RedactedConfig())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("# Applied configuration layers (later layers take precedence):")
+	for _, layer := range config.Layers {
+		fmt.Printf("#   %s\n", layer)
+	}
+	fmt.Println()
+	fmt.Print(string(data))
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	sdkDelegation "github.com/harmony-one/go-lib/staking/delegation"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony/numeric"
+	"github.com/spf13/cobra"
+)
+
+// DelegationsArguments - represents the arguments for the delegations command
+type DelegationsArguments struct {
+	Format      string
+	ByValidator bool
+}
+
+var delegationsArgs DelegationsArguments
+
+// DelegationDetails - a delegation including its undelegations and rewards
+type DelegationDetails struct {
+	Validator     string                `json:"validator"`
+	Delegator     string                `json:"delegator"`
+	Amount        string                `json:"amount"`
+	Reward        string                `json:"reward"`
+	Undelegations []UndelegationDetails `json:"undelegations"`
+}
+
+// UndelegationDetails - an undelegation including the epoch its funds are released in
+type UndelegationDetails struct {
+	Amount       string `json:"amount"`
+	Epoch        int    `json:"epoch"`
+	ReleaseEpoch uint64 `json:"release_epoch,omitempty"`
+}
+
+func init() {
+	delegationsCommand := &cobra.Command{
+		Use:   "delegations <address>",
+		Short: "Show the delegations, undelegations (including their release epochs) and rewards of a delegator",
+		Long:  "Show the delegations, undelegations (including their release epochs) and rewards of a delegator - or of all delegators of a validator using --by-validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showDelegations(args[0])
+		},
+	}
+	delegationsCommand.Flags().StringVar(&delegationsArgs.Format, "format", "table", "--format <table|json>")
+	delegationsCommand.Flags().BoolVar(&delegationsArgs.ByValidator, "by-validator", false, "--by-validator - treat the address as a validator address")
+
+	config.RootCommand.AddCommand(delegationsCommand)
+}
+
+func showDelegations(address string) error {
+	if err := validateFormat(delegationsArgs.Format); err != nil {
+		return err
+	}
+
+	if err := configure(); err != nil {
+		return err
+	}
+
+	var delegations []sdkDelegation.DelegationInfo
+	var err error
+	if delegationsArgs.ByValidator {
+		delegations, err = staking.DelegationsByValidator(address)
+	} else {
+		delegations, err = staking.DelegationsByDelegator(address)
+	}
+	if err != nil {
+		return err
+	}
+
+	details := delegationDetails(delegations)
+
+	if delegationsArgs.Format == "json" {
+		return printJSON(details)
+	}
+
+	if len(details) == 0 {
+		fmt.Printf("%s doesn't have any delegations on %s\n", address, config.Configuration.Network.Name)
+		return nil
+	}
+
+	return printDelegations(os.Stdout, details)
+}
+
+func delegationDetails(delegations []sdkDelegation.DelegationInfo) []DelegationDetails {
+	details := []DelegationDetails{}
+
+	for _, delegation := range delegations {
+		detail := DelegationDetails{
+			Validator:     delegation.ValidatorAddress,
+			Delegator:     delegation.DelegatorAddress,
+			Amount:        decimal(delegation.Amount),
+			Reward:        decimal(delegation.Reward),
+			Undelegations: []UndelegationDetails{},
+		}
+
+		for _, undelegation := range delegation.Undelegations {
+			detail.Undelegations = append(detail.Undelegations, UndelegationDetails{
+				Amount:       decimal(undelegation.Amount),
+				Epoch:        undelegation.Epoch,
+				ReleaseEpoch: config.Configuration.Network.Profile.UndelegationReleaseEpoch(uint64(undelegation.Epoch)),
+			})
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+func printDelegations(output io.Writer, details []DelegationDetails) error {
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "Delegator\tValidator\tAmount\tReward\tUndelegations")
+
+	for _, detail := range details {
+		undelegations := []string{}
+		for _, undelegation := range detail.Undelegations {
+			if undelegation.ReleaseEpoch > 0 {
+				undelegations = append(undelegations, fmt.Sprintf("%s (epoch %d, released in epoch %d)", undelegation.Amount, undelegation.Epoch, undelegation.ReleaseEpoch))
+			} else {
+				undelegations = append(undelegations, fmt.Sprintf("%s (epoch %d)", undelegation.Amount, undelegation.Epoch))
+			}
+		}
+
+		if len(undelegations) == 0 {
+			undelegations = append(undelegations, "-")
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", detail.Delegator, detail.Validator, detail.Amount, detail.Reward, strings.Join(undelegations, ", "))
+	}
+
+	return writer.Flush()
+}
+
+func validateFormat(format string) error {
+	if format != "table" && format != "json" {
+		return fmt.Errorf("unknown format %s - valid formats: table, json", format)
+	}
+
+	return nil
+}
+
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+func decimal(value numeric.Dec) string {
+	if value.IsNil() {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdkNetworkUtils "github.com/harmony-one/go-lib/network/utils"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/history"
+	"github.com/spf13/cobra"
+)
+
+// HistoryArguments - represents the arguments for the history command
+type HistoryArguments struct {
+	Runs      int
+	Threshold float64
+}
+
+var historyArgs HistoryArguments
+
+func init() {
+	historyCommand := &cobra.Command{
+		Use:   "history",
+		Short: "Show pass rate trends, duration regressions and flipping test cases",
+		Long:  "Compare the last N recorded runs of the network - shows the pass rate per run, test cases whose latest duration exceeds the median of the previous runs and test cases that have flipped between passing and failing",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showHistory()
+		},
+	}
+	historyCommand.Flags().IntVar(&historyArgs.Runs, "runs", 0, "--runs <count> (defaults to history.runs)")
+	historyCommand.Flags().Float64Var(&historyArgs.Threshold, "threshold", 1.5, "--threshold <factor> - report test cases slower than <factor> times their median duration")
+
+	config.RootCommand.AddCommand(historyCommand)
+}
+
+func showHistory() error {
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := config.LoadLayeredConfig(basePath); err != nil {
+		return err
+	}
+	config.Configuration.Framework.BasePath = basePath
+	config.Configuration.History.Initialize()
+
+	network := config.Configuration.Network.Name
+	if normalized := sdkNetworkUtils.NormalizedNetworkName(network); normalized != "" {
+		network = normalized
+	}
+
+	runs := historyArgs.Runs
+	if runs <= 0 {
+		runs = config.Configuration.History.Runs
+	}
+
+	store, err := history.Open(config.Configuration.History.Path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	trend, err := history.LoadTrend(store, network, runs)
+	if err != nil {
+		return err
+	}
+
+	if len(trend.Runs) == 0 {
+		fmt.Printf("No runs have been recorded for %s in %s\n", network, config.Configuration.History.Path)
+		return nil
+	}
+
+	fmt.Printf("Pass rate trend - last %d run(s) on %s:\n", len(trend.Runs), network)
+	fmt.Println(strings.Repeat("-", 50))
+	for _, run := range trend.Runs {
+		fmt.Printf("%s  %s@%s  %d/%d passed (%.1f%%), %d dismissed, took %v\n", run.ID, run.Version, run.Commit, run.Successful, run.Successful+run.Failed, run.PassRate()*100, run.Dismissed, run.Duration.Round(time.Second))
+	}
+	fmt.Println(strings.Repeat("-", 50))
+	fmt.Println()
+
+	regressions := trend.Regressions(historyArgs.Threshold)
+	fmt.Printf("Duration regressions - latest run at least %.2fx slower than the median of the previous runs:\n", historyArgs.Threshold)
+	fmt.Println(strings.Repeat("-", 50))
+	if len(regressions) == 0 {
+		fmt.Println("None")
+	}
+	for _, regression := range regressions {
+		fmt.Printf("%s: %v (median: %v, %.2fx)\n", regression.TestCase, regression.Latest.Round(time.Second), regression.Baseline.Round(time.Second), regression.Ratio)
+	}
+	fmt.Println(strings.Repeat("-", 50))
+	fmt.Println()
+
+	flips := trend.Flips()
+	fmt.Println("Flipping test cases - outcome per run, oldest first (P: passed, F: failed, D: dismissed, -: not part of the run):")
+	fmt.Println(strings.Repeat("-", 50))
+	if len(flips) == 0 {
+		fmt.Println("None")
+	}
+	for _, flip := range flips {
+		fmt.Printf("%s: %s (%d flip(s))\n", flip.TestCase, outcomes(flip.Outcomes), flip.Flips)
+	}
+	fmt.Println(strings.Repeat("-", 50))
+
+	return nil
+}
+
+func outcomes(results []*history.Result) string {
+	var builder strings.Builder
+
+	for _, result := range results {
+		switch {
+		case result == nil:
+			builder.WriteString("-")
+		case !result.Executed:
+			builder.WriteString("D")
+		case result.Successful:
+			builder.WriteString("P")
+		default:
+			builder.WriteString("F")
+		}
+	}
+
+	return builder.String()
+}
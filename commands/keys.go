@@ -0,0 +1,294 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/balances"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/keys"
+	"github.com/harmony-one/harmony/numeric"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	keysCommand := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the source keys of the current network",
+	}
+
+	keysCommand.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List all source keys including their per shard balances and status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listKeys()
+		},
+	})
+
+	keysCommand.AddCommand(&cobra.Command{
+		Use:   "restore [address...]",
+		Short: "Restore quarantined keys - restores all quarantined keys if no addresses are specified",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return restoreKeys(args)
+		},
+	})
+
+	encryptCommand := &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypt plaintext private keys to a key bundle using the account passphrase",
+		Long:  "Encrypt plaintext private keys to a key bundle that can be used with the bundle key source. Supply the passphrase using --passphrase-file or --passphrase-stdin to keep it out of the process list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return encryptKeys()
+		},
+	}
+	encryptCommand.Flags().StringVar(&encryptArgs.Input, "input", "", "--input <path>")
+	encryptCommand.Flags().StringVar(&encryptArgs.Output, "output", "", "--output <path>")
+	keysCommand.AddCommand(encryptCommand)
+
+	deriveCommand := &cobra.Command{
+		Use:   "derive <name...>",
+		Short: "Derive generated accounts from the seed and run to locate (and recover) their funds without the keystore",
+		Long:  "Derive generated accounts from --seed and --run. The arguments are full account names (as logged during the run) or roles (e.g. Sender, Receiver_0) when --test-case is used",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deriveKeys(args)
+		},
+	}
+	deriveCommand.Flags().StringVar(&deriveArgs.TestCase, "test-case", "", "--test-case <test case name>")
+	deriveCommand.Flags().BoolVar(&deriveArgs.Import, "import", false, "--import")
+	keysCommand.AddCommand(deriveCommand)
+
+	vaultServeCommand := &cobra.Command{
+		Use:   "vault-serve",
+		Short: "Run a stand-in Vault KV store serving plaintext private keys to the vault key source",
+		Long:  "Run a stand-in Vault KV store serving the private keys of a plaintext keys file at the configured mount, path and field (requiring VAULT_TOKEN if it's set) - meant for verifying the vault key source locally, don't expose it on a public interface",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveVault()
+		},
+	}
+	vaultServeCommand.Flags().StringVar(&vaultServeArgs.Input, "input", "", "--input <path>")
+	vaultServeCommand.Flags().StringVar(&vaultServeArgs.Listen, "listen", "127.0.0.1:8200", "--listen <host:port>")
+	keysCommand.AddCommand(vaultServeCommand)
+
+	config.RootCommand.AddCommand(keysCommand)
+}
+
+// DeriveArguments - represents the arguments for the keys derive command
+type DeriveArguments struct {
+	TestCase string
+	Import   bool
+}
+
+var deriveArgs DeriveArguments
+
+// EncryptArguments - represents the arguments for the keys encrypt command
+type EncryptArguments struct {
+	Input  string
+	Output string
+}
+
+var encryptArgs EncryptArguments
+
+// VaultServeArguments - represents the arguments for the keys vault-serve command
+type VaultServeArguments struct {
+	Input  string
+	Listen string
+}
+
+var vaultServeArgs VaultServeArguments
+
+func listKeys() error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	sourceKeys, err := keys.ListKeys()
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"Address", "Status"}
+	for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+		header = append(header, fmt.Sprintf("Shard %d", shardID))
+	}
+	header = append(header, "Path")
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, key := range sourceKeys {
+		totalBalance := numeric.NewDec(0)
+		row := []string{key.Address, ""}
+
+		for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+			balance, err := balances.GetShardBalance(key.Address, uint32(shardID))
+			if err != nil || balance.IsNil() {
+				row = append(row, "n/a")
+				continue
+			}
+			totalBalance = totalBalance.Add(balance)
+			row = append(row, balance.String())
+		}
+
+		row[1] = keyStatus(key, totalBalance)
+		row = append(row, key.Path)
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func keyStatus(key keys.KeyDetails, totalBalance numeric.Dec) string {
+	if key.Quarantined {
+		return "quarantined"
+	}
+
+	if totalBalance.GT(config.Configuration.Funding.MinimumFunds) {
+		return "funded"
+	}
+
+	return "unfunded"
+}
+
+func restoreKeys(addresses []string) error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	restored, err := keys.RestoreKeys(addresses)
+	for _, entry := range restored {
+		fmt.Println(fmt.Sprintf("Restored the key for address %s to %s", entry.Address, entry.SourcePath))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(restored) == 0 {
+		fmt.Println(fmt.Sprintf("There are no quarantined keys to restore in %s", keys.QuarantinePath()))
+	}
+
+	return nil
+}
+
+func encryptKeys() error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	if config.Configuration.Account.Passphrase == "" {
+		return errors.New("a passphrase is required to encrypt a key bundle - supply it using --passphrase-file or --passphrase-stdin")
+	}
+
+	if encryptArgs.Input == "" {
+		encryptArgs.Input = keys.PrivateKeysPath()
+	}
+
+	if encryptArgs.Output == "" {
+		encryptArgs.Output = config.Configuration.Account.Keys.Bundle
+	}
+
+	source := keys.FileKeySource{Path: encryptArgs.Input}
+	privateKeys, err := source.PrivateKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(privateKeys) == 0 {
+		return fmt.Errorf("couldn't find any private keys in %s", encryptArgs.Input)
+	}
+
+	bundle, err := keys.EncryptKeyBundle(privateKeys, config.Configuration.Account.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(encryptArgs.Output, bundle, 0600); err != nil {
+		return err
+	}
+
+	fmt.Println(fmt.Sprintf("Encrypted %d private key(s) from %s to %s - enable the bundle key source using --key-sources bundle and remove the plaintext file once verified", len(privateKeys), encryptArgs.Input, encryptArgs.Output))
+
+	return nil
+}
+
+func serveVault() error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	if vaultServeArgs.Input == "" {
+		vaultServeArgs.Input = keys.PrivateKeysPath()
+	}
+
+	source := keys.FileKeySource{Path: vaultServeArgs.Input}
+	privateKeys, err := source.PrivateKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(privateKeys) == 0 {
+		return fmt.Errorf("couldn't find any private keys in %s", vaultServeArgs.Input)
+	}
+
+	standIn := &keys.VaultStandIn{
+		Settings:    config.Configuration.Account.Keys.Vault,
+		PrivateKeys: privateKeys,
+	}
+
+	fmt.Println(fmt.Sprintf("Stand-in Vault KV store serving %d private key(s) from %s at http://%s%s (KV version %d) - use it with --key-sources vault and VAULT_ADDR=http://%s", len(privateKeys), vaultServeArgs.Input, vaultServeArgs.Listen, standIn.SecretPath(), standIn.Settings.Version, vaultServeArgs.Listen))
+
+	return http.ListenAndServe(vaultServeArgs.Listen, standIn)
+}
+
+func deriveKeys(names []string) error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	if config.Configuration.Framework.GeneratedRun {
+		return errors.New("the accounts can only be derived using the run they were generated with - supply it using --run (it's logged at the start of every run using a seed)")
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"Name", "Address", "Path"}
+	for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+		header = append(header, fmt.Sprintf("Shard %d", shardID))
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, name := range names {
+		if deriveArgs.TestCase != "" {
+			name = accounts.GenerateTestCaseAccountName(deriveArgs.TestCase, name)
+		}
+
+		key, err := accounts.DeriveKey(config.Configuration.Framework.Seed, config.Configuration.Framework.Run, name)
+		if err != nil {
+			return err
+		}
+
+		if deriveArgs.Import {
+			if _, err := accounts.ImportDerivedKey(key); err != nil {
+				return err
+			}
+		}
+
+		row := []string{key.Name, key.Address, key.Path}
+		for shardID := 0; shardID < config.Configuration.Network.Shards; shardID++ {
+			balance, err := balances.GetShardBalance(key.Address, uint32(shardID))
+			if err != nil || balance.IsNil() {
+				row = append(row, "n/a")
+				continue
+			}
+			row = append(row, balance.String())
+		}
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/notifications"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NotificationsArguments - represents the arguments for the notifications command
+type NotificationsArguments struct {
+	Event  string
+	Sink   string
+	HTTP   string
+	SMTP   string
+	Failed bool
+}
+
+var notificationsArgs NotificationsArguments
+
+func init() {
+	notificationsCommand := &cobra.Command{
+		Use:   "notifications",
+		Short: "Notification sink tooling",
+	}
+
+	testCommand := &cobra.Command{
+		Use:   "test",
+		Short: "Send a sample notification to the configured sinks",
+		Long:  "Send a sample notification to every configured sink subscribed to the event (or to a single sink using --sink) - the sink filters are applied as they would be at the end of a run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return testNotifications()
+		},
+	}
+	testCommand.Flags().StringVar(&notificationsArgs.Event, "event", notifications.CompletedEvent, fmt.Sprintf("--event <%s|%s>", notifications.CompletedEvent, notifications.FirstFailureEvent))
+	testCommand.Flags().StringVar(&notificationsArgs.Sink, "sink", "", "--sink <name> - only notify the sink with the given name, ignoring its filters")
+	testCommand.Flags().BoolVar(&notificationsArgs.Failed, "failed", false, "--failed - send a sample notification for a failed run")
+	notificationsCommand.AddCommand(testCommand)
+
+	serveCommand := &cobra.Command{
+		Use:   "serve",
+		Short: "Run stand-in HTTP and SMTP servers printing the notifications they receive",
+		Long:  "Run stand-in HTTP (webhook/slack) and SMTP servers printing every notification they receive - meant for verifying the notification sinks locally, don't expose them on a public interface",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveNotifications()
+		},
+	}
+	serveCommand.Flags().StringVar(&notificationsArgs.HTTP, "http", "127.0.0.1:9800", "--http <host:port>")
+	serveCommand.Flags().StringVar(&notificationsArgs.SMTP, "smtp", "127.0.0.1:2525", "--smtp <host:port>")
+	notificationsCommand.AddCommand(serveCommand)
+
+	config.RootCommand.AddCommand(notificationsCommand)
+}
+
+func testNotifications() error {
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := config.LoadLayeredConfig(basePath); err != nil {
+		return err
+	}
+	config.Configuration.Framework.BasePath = basePath
+	config.Configuration.Framework.StartTime = time.Now().UTC()
+
+	if err := config.Configuration.Notifications.Initialize(); err != nil {
+		return err
+	}
+
+	if len(config.Configuration.Notifications.Sinks) == 0 {
+		fmt.Println("No notification sinks have been configured - add them to the notifications section of config.yml")
+		return nil
+	}
+
+	notification, err := sampleNotification()
+	if err != nil {
+		return err
+	}
+
+	if notificationsArgs.Sink == "" {
+		if err := notifications.Notify(notification); err != nil {
+			return err
+		}
+		fmt.Printf("Sent a sample %s notification to the subscribed sinks\n", notification.Event)
+		return nil
+	}
+
+	for index := range config.Configuration.Notifications.Sinks {
+		sink := &config.Configuration.Notifications.Sinks[index]
+		if sink.Name == notificationsArgs.Sink {
+			if err := notifications.Send(sink, notification); err != nil {
+				return errors.Wrapf(err, "Sink %s", sink.Name)
+			}
+			fmt.Printf("Sent a sample %s notification to %s\n", notification.Event, sink.Name)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Couldn't find a notification sink named %s", notificationsArgs.Sink)
+}
+
+func sampleNotification() (notifications.Notification, error) {
+	passed := &testing.TestCase{Name: "Sample - successful test case", Category: "sample", Scenario: "standard", Result: true, Expected: true}
+	failed := &testing.TestCase{Name: "Sample - failed test case", Category: "sample", Scenario: "standard", Result: false, Expected: true, Error: fmt.Errorf("sample error")}
+	dismissed := &testing.TestCase{Name: "Sample - dismissed test case", Category: "sample", Scenario: "standard", Dismissal: "sample dismissal"}
+
+	switch notificationsArgs.Event {
+	case notifications.CompletedEvent:
+		if notificationsArgs.Failed {
+			return notifications.Completed([]*testing.TestCase{passed, failed}, []*testing.TestCase{dismissed}, 1, 1, time.Minute), nil
+		}
+		return notifications.Completed([]*testing.TestCase{passed}, []*testing.TestCase{dismissed}, 1, 0, time.Minute), nil
+	case notifications.FirstFailureEvent:
+		return notifications.FirstFailure(failed, 2), nil
+	default:
+		return notifications.Notification{}, fmt.Errorf("Unknown event %s - valid events: %s, %s", notificationsArgs.Event, notifications.CompletedEvent, notifications.FirstFailureEvent)
+	}
+}
+
+func serveNotifications() error {
+	standIn := &notifications.StandIn{Output: os.Stdout}
+
+	listener, err := net.Listen("tcp", notificationsArgs.SMTP)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	errs := make(chan error, 2)
+	go func() { errs <- standIn.ServeSMTP(listener) }()
+	go func() { errs <- http.ListenAndServe(notificationsArgs.HTTP, standIn) }()
+
+	fmt.Println(fmt.Sprintf("Stand-in notification sinks listening on http://%s (webhook/slack) and smtp://%s", notificationsArgs.HTTP, notificationsArgs.SMTP))
+
+	return <-errs
+}
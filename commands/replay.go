@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony-tf/utils"
+	"github.com/spf13/cobra"
+)
+
+// ReplayArguments - represents the arguments for the replay command
+type ReplayArguments struct {
+	File    string
+	Hashes  []string
+	Indexes []int
+	Resign  bool
+}
+
+var replayArgs ReplayArguments
+
+func init() {
+	replayCommand := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-broadcast recorded raw transactions",
+		Long:  "Re-broadcast raw signed transactions recorded using --record-raw-txs, either as-is or re-signed using a fresh nonce. Use --node to target a specific node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return replay()
+		},
+	}
+
+	replayCommand.Flags().StringVar(&replayArgs.File, "file", "", "--file <path>")
+	replayCommand.Flags().StringSliceVar(&replayArgs.Hashes, "hashes", []string{}, "--hashes hash1,hash2")
+	replayCommand.Flags().IntSliceVar(&replayArgs.Indexes, "indexes", []int{}, "--indexes 0,1")
+	replayCommand.Flags().BoolVar(&replayArgs.Resign, "resign", false, "--resign")
+
+	config.RootCommand.AddCommand(replayCommand)
+}
+
+func replay() error {
+	if replayArgs.File == "" {
+		return errors.New("you need to specify the raw transaction file to replay using --file")
+	}
+
+	rawTxs, err := transactions.LoadRawTransactions(replayArgs.File)
+	if err != nil {
+		return err
+	}
+
+	selectedTxs := selectRawTransactions(rawTxs)
+	if len(selectedTxs) == 0 {
+		return fmt.Errorf("couldn't find any matching raw transactions in %s", replayArgs.File)
+	}
+
+	if err := configure(); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, rawTx := range selectedTxs {
+		tx, err := replayRawTransaction(rawTx)
+		if err != nil {
+			failed++
+			logger.ErrorLog(fmt.Sprintf("Failed to replay transaction %s from %s (shard %d, nonce %d) - error: %s", rawTx.TransactionHash, rawTx.Sender, rawTx.FromShardID, rawTx.Nonce, err.Error()), true)
+			continue
+		}
+
+		if !tx.Success {
+			failed++
+		}
+
+		txResultColoring := logger.ResultColoring(tx.Success, true)
+		logger.TransactionLog(fmt.Sprintf("Replayed transaction %s from %s (shard %d) - transaction hash: %s, tx successful: %s", rawTx.TransactionHash, rawTx.Sender, rawTx.FromShardID, tx.TransactionHash, txResultColoring), true)
+	}
+
+	fmt.Printf("Replayed %d transaction(s) - %d successful, %d failed\n", len(selectedTxs), len(selectedTxs)-failed, failed)
+
+	return nil
+}
+
+func selectRawTransactions(rawTxs []transactions.RawTransaction) (selectedTxs []transactions.RawTransaction) {
+	if len(replayArgs.Hashes) == 0 && len(replayArgs.Indexes) == 0 {
+		return rawTxs
+	}
+
+	hashes := []string{}
+	for _, hash := range replayArgs.Hashes {
+		hashes = append(hashes, strings.ToLower(hash))
+	}
+
+	for index, rawTx := range rawTxs {
+		if utils.StringSliceContains(hashes, strings.ToLower(rawTx.TransactionHash)) || intSliceContains(replayArgs.Indexes, index) {
+			selectedTxs = append(selectedTxs, rawTx)
+		}
+	}
+
+	return selectedTxs
+}
+
+func replayRawTransaction(rawTx transactions.RawTransaction) (sdkTxs.Transaction, error) {
+	chainID, err := rawTx.ChainIDValue()
+	if err != nil {
+		return sdkTxs.Transaction{}, err
+	}
+
+	if rawTx.Type == "eth_transaction" {
+		config.Configuration.Network.ChangeRPCSettings("eth", chainID)
+		defer config.Configuration.Network.RevertRPCSettings()
+	}
+
+	signedTx := rawTx.Hex
+	if replayArgs.Resign {
+		if signedTx, err = resignRawTransaction(rawTx, chainID); err != nil {
+			return sdkTxs.Transaction{}, err
+		}
+	}
+
+	result, err := transactions.SendRawTransaction(signedTx, rawTx.FromShardID, timeout())
+	if err != nil {
+		return sdkTxs.Transaction{}, err
+	}
+
+	return sdkTxs.ToTransaction(rawTx.Sender, rawTx.FromShardID, rawTx.Receiver, rawTx.ToShardID, result, nil), nil
+}
+
+// resignRawTransaction - re-signs a recorded transaction using the sender's current nonce
+func resignRawTransaction(rawTx transactions.RawTransaction, chainID *common.ChainID) (string, error) {
+	account, err := accounts.FindAccountByAddress(rawTx.Sender)
+	if err != nil {
+		return "", err
+	}
+
+	amount, err := common.NewDecFromString(rawTx.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	gasPrice, err := common.NewDecFromString(rawTx.GasPrice)
+	if err != nil {
+		return "", err
+	}
+
+	if rawTx.Type == "eth_transaction" {
+		return transactions.SignEthTransaction(&account, chainID, rawTx.FromShardID, rawTx.Receiver, amount, -1, rawTx.GasLimit, gasPrice, rawTx.Data)
+	}
+
+	return transactions.SignTransaction(&account, chainID, rawTx.FromShardID, rawTx.Receiver, rawTx.ToShardID, amount, -1, rawTx.GasLimit, gasPrice, rawTx.Data)
+}
+
+func intSliceContains(slice []int, value int) bool {
+	for _, item := range slice {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
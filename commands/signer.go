@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/signers"
+	"github.com/spf13/cobra"
+)
+
+// SignerArguments - represents the arguments for the signer command
+type SignerArguments struct {
+	Listen string
+}
+
+var signerArgs SignerArguments
+
+func init() {
+	signerCommand := &cobra.Command{
+		Use:   "signer",
+		Short: "Remote signer tooling",
+	}
+
+	serveCommand := &cobra.Command{
+		Use:   "serve",
+		Short: "Run a stand-in remote signer backed by the local keystore",
+		Long:  "Run a stand-in remote signer that serves the remote signing protocol using the accounts in the local keystore - meant for verifying a remote signer setup locally, don't expose it on a public interface",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveSigner()
+		},
+	}
+	serveCommand.Flags().StringVar(&signerArgs.Listen, "listen", "127.0.0.1:9700", "--listen <host:port>")
+	signerCommand.AddCommand(serveCommand)
+
+	config.RootCommand.AddCommand(signerCommand)
+}
+
+func serveSigner() error {
+	if err := configure(); err != nil {
+		return err
+	}
+
+	standIn := &signers.StandInSigner{
+		Signer: &signers.LocalSigner{},
+		Lookup: accounts.FindAccountByAddress,
+	}
+
+	fmt.Println(fmt.Sprintf("Stand-in remote signer listening on http://%s", signerArgs.Listen))
+
+	return http.ListenAndServe(signerArgs.Listen, standIn)
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"path/filepath"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testcases"
+	"github.com/harmony-one/harmony-tf/validation"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	config.RootCommand.AddCommand(&cobra.Command{
+		Use:   "validate",
+		Short: "Validate config.yml, the network profiles and the test case files",
+		Long:  "Strictly parse config.yml, the network profiles and every test case file and report unknown fields, type errors, invalid decimals, unknown scenarios and shard ids outside the network - no network connection is required",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return validate(cmd.Flags().Changed("network"))
+		},
+	})
+}
+
+func validate(networkOverride bool) error {
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	network := ""
+	if networkOverride {
+		network = config.Args.Network
+	}
+
+	report := validation.Validate(basePath, network, testcases.Scenarios)
+	report.Print()
+
+	return report.Error()
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	sdkValidator "github.com/harmony-one/go-lib/staking/validator"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/spf13/cobra"
+)
+
+// ValidatorArguments - represents the arguments for the validator command
+type ValidatorArguments struct {
+	Format string
+}
+
+var validatorArgs ValidatorArguments
+
+// ValidatorDetails - the live information of a validator
+type ValidatorDetails struct {
+	Address              string              `json:"address"`
+	Name                 string              `json:"name"`
+	Identity             string              `json:"identity"`
+	Website              string              `json:"website"`
+	SecurityContact      string              `json:"security_contact"`
+	Details              string              `json:"details"`
+	Rate                 string              `json:"rate"`
+	MaxRate              string              `json:"max_rate"`
+	MaxChangeRate        string              `json:"max_change_rate"`
+	MinSelfDelegation    string              `json:"min_self_delegation"`
+	MaxTotalDelegation   string              `json:"max_total_delegation"`
+	TotalDelegation      string              `json:"total_delegation"`
+	BLSKeys              []string            `json:"bls_keys"`
+	Eligibility          string              `json:"eligibility"`
+	EposStatus           string              `json:"epos_status"`
+	InCommittee          bool                `json:"in_committee"`
+	LastEpochInCommittee uint32              `json:"last_epoch_in_committee"`
+	CreationHeight       uint32              `json:"creation_height"`
+	UpdateHeight         uint32              `json:"update_height"`
+	BlocksSigned         uint32              `json:"blocks_signed"`
+	BlocksToSign         uint32              `json:"blocks_to_sign"`
+	LifetimeRewards      string              `json:"lifetime_rewards"`
+	APR                  string              `json:"apr"`
+	Delegations          []DelegationDetails `json:"delegations"`
+}
+
+func init() {
+	validatorCommand := &cobra.Command{
+		Use:   "validator",
+		Short: "Validator tooling",
+	}
+
+	showCommand := &cobra.Command{
+		Use:   "show <address>",
+		Short: "Show the description, commission rates, BLS keys, eligibility and delegations of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showValidator(args[0])
+		},
+	}
+	showCommand.Flags().StringVar(&validatorArgs.Format, "format", "table", "--format <table|json>")
+	validatorCommand.AddCommand(showCommand)
+
+	config.RootCommand.AddCommand(validatorCommand)
+}
+
+func showValidator(address string) error {
+	if err := validateFormat(validatorArgs.Format); err != nil {
+		return err
+	}
+
+	if err := configure(); err != nil {
+		return err
+	}
+
+	validatorInfo, err := staking.ValidatorInformation(address, 0)
+	if err != nil {
+		return err
+	}
+
+	if validatorInfo.Validator.Address == "" {
+		return fmt.Errorf("Couldn't find the validator %s on %s", address, config.Configuration.Network.Name)
+	}
+
+	details := validatorDetails(validatorInfo)
+
+	if validatorArgs.Format == "json" {
+		return printJSON(details)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	rows := [][]string{
+		{"Address", details.Address},
+		{"Name", details.Name},
+		{"Identity", details.Identity},
+		{"Website", details.Website},
+		{"Security contact", details.SecurityContact},
+		{"Details", details.Details},
+		{"Commission rate", details.Rate},
+		{"Max commission rate", details.MaxRate},
+		{"Max commission change rate", details.MaxChangeRate},
+		{"Min self delegation", details.MinSelfDelegation},
+		{"Max total delegation", details.MaxTotalDelegation},
+		{"Total delegation", details.TotalDelegation},
+		{"Eligibility", details.Eligibility},
+		{"EPoS status", details.EposStatus},
+		{"In committee", fmt.Sprintf("%t", details.InCommittee)},
+		{"Last epoch in committee", fmt.Sprintf("%d", details.LastEpochInCommittee)},
+		{"Created/updated at block", fmt.Sprintf("%d/%d", details.CreationHeight, details.UpdateHeight)},
+		{"Blocks signed", fmt.Sprintf("%d/%d", details.BlocksSigned, details.BlocksToSign)},
+		{"Lifetime rewards", details.LifetimeRewards},
+		{"APR", details.APR},
+	}
+	for index, key := range details.BLSKeys {
+		rows = append(rows, []string{fmt.Sprintf("BLS key %d", index), key})
+	}
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	if len(details.Delegations) == 0 {
+		fmt.Println("No delegations")
+		return nil
+	}
+
+	return printDelegations(os.Stdout, details.Delegations)
+}
+
+func validatorDetails(validatorInfo sdkValidator.RPCValidatorResult) ValidatorDetails {
+	validator := validatorInfo.Validator
+
+	return ValidatorDetails{
+		Address:              validator.Address,
+		Name:                 validator.Name,
+		Identity:             validator.Identity,
+		Website:              validator.Website,
+		SecurityContact:      validator.SecurityContact,
+		Details:              validator.Details,
+		Rate:                 decimal(validator.Rate),
+		MaxRate:              decimal(validator.MaxRate),
+		MaxChangeRate:        decimal(validator.MaxChangeRate),
+		MinSelfDelegation:    decimal(validator.MinSelfDelegation),
+		MaxTotalDelegation:   decimal(validator.MaxTotalDelegation),
+		TotalDelegation:      decimal(validatorInfo.TotalDelegation),
+		BLSKeys:              append([]string{}, validator.BLSPublicKeys...),
+		Eligibility:          validator.EligibilityStatus,
+		EposStatus:           validatorInfo.EposStatus,
+		InCommittee:          validatorInfo.CurrentlyInCommittee,
+		LastEpochInCommittee: validator.LastEpochInCommittee,
+		CreationHeight:       validator.CreationHeight,
+		UpdateHeight:         validator.UpdateHeight,
+		BlocksSigned:         validator.Availability.BlocksSigned,
+		BlocksToSign:         validator.Availability.BlocksToSign,
+		LifetimeRewards:      decimal(validatorInfo.Lifetime.RewardAccumulated),
+		APR:                  decimal(validatorInfo.Lifetime.APR),
+		Delegations:          delegationDetails(validator.Delegations),
+	}
+}
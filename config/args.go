@@ -21,16 +21,29 @@ type CommandArguments struct {
 	Nodes          []string
 	Path           string
 	Export         string
+	SummaryFile    string
+	ExportTxs      string
+	Invariants     bool
 	ExportPath     string
+	RecordRawTxs   bool
+	BatchFunding   bool
 	FundingAddress string
 	MinimumFunds   string
 	Passphrase     string
+	PassphraseFile string
+	PassStdin      bool
+	KeySources     []string
+	Signer         string
+	SignerURL      string
 	KeysPath       string
 	TestTarget     string
+	Seed           string
+	Run            string
 	Timeout        int
 	Verbose        bool
 	VerboseGoSDK   bool
 	PprofPort      int
+	Overrides      []string
 }
 
 var (
@@ -45,6 +58,9 @@ var (
 		},
 	}
 
+	// Commit - the commit the binary was built from, set using -ldflags "-X github.com/harmony-one/harmony-tf/config.Commit=<commit>"
+	Commit = "unknown"
+
 	// VersionWrap - binary version string
 	VersionWrap = fmt.Sprintf("Harmony (C) 2020. %v, version %s/%s-%s\n", path.Base(os.Args[0]), runtime.Version(), runtime.GOOS, runtime.GOARCH)
 )
@@ -56,34 +72,53 @@ func init() {
 	RootCommand.PersistentFlags().StringVar(&Args.Node, "node", "", "--node <node>")
 	RootCommand.PersistentFlags().StringSliceVar(&Args.Nodes, "nodes", []string{}, "--nodes node1,node2")
 	RootCommand.PersistentFlags().StringVar(&Args.Path, "path", ".", "<path>")
-	RootCommand.PersistentFlags().StringVar(&Args.Export, "export", ".", "<path>")
+	RootCommand.PersistentFlags().StringVar(&Args.Export, "export", "", "--export <csv|html>")
+	RootCommand.PersistentFlags().StringVar(&Args.ExportTxs, "export-txs", "", "--export-txs <csv|json> - export every transaction sent by the test cases")
+	RootCommand.PersistentFlags().StringVar(&Args.SummaryFile, "summary-file", "", "--summary-file <path> - write a markdown summary of the run (e.g. $GITHUB_STEP_SUMMARY)")
 	RootCommand.PersistentFlags().StringVar(&Args.ExportPath, "export-path", "./export", "<path>")
+	RootCommand.PersistentFlags().BoolVar(&Args.RecordRawTxs, "record-raw-txs", false, "--record-raw-txs")
+	RootCommand.PersistentFlags().BoolVar(&Args.BatchFunding, "batch-funding", false, "--batch-funding")
 	RootCommand.PersistentFlags().StringVar(&Args.FundingAddress, "address", "", "--address <address>")
 	RootCommand.PersistentFlags().StringVar(&Args.MinimumFunds, "minimum-funds", "100.0", "--minimum-funds <funds>")
 	RootCommand.PersistentFlags().StringVar(&Args.Passphrase, "passphrase", "", "--passphrase <passphrase>")
+	RootCommand.PersistentFlags().StringVar(&Args.PassphraseFile, "passphrase-file", "", "--passphrase-file <path>")
+	RootCommand.PersistentFlags().BoolVar(&Args.PassStdin, "passphrase-stdin", false, "--passphrase-stdin")
+	RootCommand.PersistentFlags().StringSliceVar(&Args.KeySources, "key-sources", []string{}, "--key-sources file,bundle,env,vault")
+	RootCommand.PersistentFlags().StringVar(&Args.Signer, "signer", "", "--signer <local|remote>")
+	RootCommand.PersistentFlags().StringVar(&Args.SignerURL, "signer-url", "", "--signer-url <url>")
 	RootCommand.PersistentFlags().StringVar(&Args.KeysPath, "keys", "", "--keys <path>")
 	RootCommand.PersistentFlags().StringVar(&Args.TestTarget, "test", "", "--test <path>")
+	RootCommand.PersistentFlags().StringVar(&Args.Seed, "seed", "", "--seed <seed>")
+	RootCommand.PersistentFlags().StringVar(&Args.Run, "run", "", "--run <run>")
 	RootCommand.PersistentFlags().IntVar(&Args.Timeout, "timeout", 0, "<timeout>")
+	RootCommand.PersistentFlags().BoolVar(&Args.Invariants, "check-invariants", false, "--check-invariants - snapshot the chain state before and after every test case and report leaks")
 	RootCommand.PersistentFlags().BoolVar(&Args.Verbose, "verbose", false, "--verbose")
 	RootCommand.PersistentFlags().BoolVar(&Args.VerboseGoSDK, "verbose-go-sdk", false, "--verbose-go-sdk")
 	RootCommand.PersistentFlags().IntVar(&Args.PprofPort, "pprof-port", -1, "--pprof-port <port>")
+	RootCommand.PersistentFlags().StringArrayVar(&Args.Overrides, "set", []string{}, "--set <path>=<value> (e.g. --set network.timeout=60)")
 
 	RootCommand.AddCommand(&cobra.Command{
 		Use:   "version",
 		Short: "Show version",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Fprintf(os.Stderr, VersionWrap)
-			os.Exit(1)
+			fmt.Fprint(os.Stdout, VersionWrap)
 			return nil
 		},
 	})
 }
 
-// Execute starts the actual app
-func Execute() {
+// Execute starts the actual app - returns whether or not the regular test suite should be executed, i.e. neither a subcommand nor the help has been executed
+func Execute() bool {
 	RootCommand.SilenceErrors = true
-	if err := RootCommand.Execute(); err != nil {
+	cmd, err := RootCommand.ExecuteC()
+	if err != nil {
 		fmt.Println(errors.Wrapf(err, "commit: %s, error", VersionWrap).Error())
-		os.Exit(1)
+		os.Exit(ExitCode(err))
+	}
+
+	if help, _ := cmd.Flags().GetBool("help"); help {
+		return false
 	}
+
+	return cmd == RootCommand
 }
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,12 +21,17 @@ import (
 
 // Config - represents the general configuration
 type Config struct {
-	Framework  Framework `yaml:"framework"`
-	Network    Network   `yaml:"network"`
-	Account    Account   `yaml:"account"`
-	Funding    Funding   `yaml:"funding"`
-	Export     Export    `yaml:"export"`
-	Configured bool
+	Framework     Framework     `yaml:"framework"`
+	Network       Network       `yaml:"network"`
+	Account       Account       `yaml:"account"`
+	Funding       Funding       `yaml:"funding"`
+	Teardown      Teardown      `yaml:"teardown"`
+	Signer        Signer        `yaml:"signer"`
+	Export        Export        `yaml:"export"`
+	History       History       `yaml:"history"`
+	Notifications Notifications `yaml:"notifications"`
+	Invariants    Invariants    `yaml:"invariants"`
+	Configured    bool
 }
 
 // Framework - represents common framework settings
@@ -32,6 +40,9 @@ type Framework struct {
 	Identifier            string
 	Version               string                  `yaml:"-"`
 	Test                  string                  `yaml:"test"`
+	Seed                  string                  `yaml:"seed"`
+	Run                   string                  `yaml:"run"`
+	GeneratedRun          bool                    `yaml:"-"` // Whether or not the run has been generated since it wasn't configured
 	Verbose               bool                    `yaml:"verbose"`
 	MinimumRequiredMemory uint64                  `yaml:"minimum_required_memory"`
 	SystemMemory          uint64                  `yaml:"-"` // In megabytes
@@ -76,6 +87,7 @@ type Network struct {
 	Balances             Balances                `yaml:"balances"`
 	Mutex                sync.Mutex              `yaml:"-"`
 	NetworkHistory       NetworkHistory          `yaml:"-"`
+	Profile              *NetworkProfile         `yaml:"-"`
 }
 
 //NetworkHistory - keeps track of the previous network configuration when switching between RPC settings
@@ -86,9 +98,31 @@ type NetworkHistory struct {
 
 // Account - represents the account settings group
 type Account struct {
-	Passphrase       string `yaml:"passphrase"`
-	RemoveEmpty      bool   `yaml:"remove_empty"`
-	UseAllInKeystore bool   `yaml:"use_all_in_keystore"`
+	Passphrase       string     `yaml:"passphrase"`
+	PassphraseFile   string     `yaml:"passphrase_file"`
+	RemoveEmpty      bool       `yaml:"remove_empty"`
+	UseAllInKeystore bool       `yaml:"use_all_in_keystore"`
+	Keys             KeySources `yaml:"keys"`
+}
+
+// KeySources - represents the sources that private keys are loaded from
+type KeySources struct {
+	Sources []string `yaml:"sources"`
+	Bundle  string   `yaml:"bundle"`
+	Env     string   `yaml:"env"`
+	Vault   Vault    `yaml:"vault"`
+}
+
+// Vault - represents the settings for a HashiCorp Vault compatible HTTP KV store
+type Vault struct {
+	Address   string `yaml:"address"`
+	Mount     string `yaml:"mount"`
+	Path      string `yaml:"path"`
+	Field     string `yaml:"field"`
+	Version   int    `yaml:"version"`
+	TokenFile string `yaml:"token_file"`
+	Token     string `yaml:"-"`
+	Timeout   int    `yaml:"timeout"`
 }
 
 // Funding - represents the funding settings group
@@ -101,6 +135,100 @@ type Funding struct {
 	Verbose         bool                `yaml:"verbose"`
 	Shards          string              `yaml:"shards"`
 	Gas             sdkNetworkTypes.Gas `yaml:"gas"`
+	Pool            Pool                `yaml:"pool"`
+	Batch           Batch               `yaml:"batch"`
+}
+
+// Pool - represents the funding pool settings
+type Pool struct {
+	Size         int         `yaml:"size"`
+	RawThreshold string      `yaml:"threshold"`
+	Threshold    numeric.Dec `yaml:"-"`
+	RawTarget    string      `yaml:"target"`
+	Target       numeric.Dec `yaml:"-"`
+	Interval     int         `yaml:"interval"`
+}
+
+// Batch - represents the batch funding settings
+type Batch struct {
+	Enabled      bool              `yaml:"enabled"`
+	Contracts    map[uint32]string `yaml:"contracts"`
+	MinimumCount int               `yaml:"minimum_count"`
+	GasLimit     uint64            `yaml:"gas_limit"`
+	RecipientGas uint64            `yaml:"recipient_gas"`
+}
+
+// Teardown - represents the teardown settings
+type Teardown struct {
+	Concurrency int   `yaml:"concurrency"`
+	Retry       Retry `yaml:"retry"`
+}
+
+// Invariants - represents the invariant checker settings
+type Invariants struct {
+	Enabled      bool        `yaml:"enabled"`
+	RawTolerance string      `yaml:"tolerance"`
+	Tolerance    numeric.Dec `yaml:"-"`
+}
+
+// History - represents the run history settings
+type History struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+	Runs    int    `yaml:"runs"`
+}
+
+// Notifications - represents the notification settings
+type Notifications struct {
+	Sinks []NotificationSink `yaml:"sinks"`
+}
+
+// NotificationSink - represents a notification sink (webhook, slack or smtp)
+type NotificationSink struct {
+	Name          string            `yaml:"name"`
+	Type          string            `yaml:"type"`
+	URL           string            `yaml:"url"`
+	Headers       map[string]string `yaml:"headers"`
+	Events        []string          `yaml:"events"`
+	OnlyOnFailure bool              `yaml:"only_on_failure"`
+	Template      string            `yaml:"template"`
+	TemplateFile  string            `yaml:"template_file"`
+	Timeout       int               `yaml:"timeout"`
+	SMTP          SMTP              `yaml:"smtp"`
+}
+
+// SMTP - represents the smtp settings of an email notification sink
+type SMTP struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+	Subject  string   `yaml:"subject"`
+}
+
+// Signer - represents the transaction signer settings
+type Signer struct {
+	Type      string   `yaml:"type"`
+	URL       string   `yaml:"url"`
+	Addresses []string `yaml:"addresses"`
+	Timeout   int      `yaml:"timeout"`
+}
+
+// IsRemote - whether or not transactions for a given address should be signed using the remote signer
+func (signer *Signer) IsRemote(address string) bool {
+	if signer.Type != "remote" {
+		return false
+	}
+
+	for _, remoteAddress := range signer.Addresses {
+		if strings.EqualFold(remoteAddress, address) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Retry - settings for RPC retries
@@ -116,8 +244,11 @@ type Balances struct {
 
 // Export - export settings
 type Export struct {
-	Path   string `yaml:"path"`
-	Format string `yaml:"format"`
+	Path            string `yaml:"path"`
+	Format          string `yaml:"format"`
+	RawTransactions bool   `yaml:"raw_transactions"`
+	SummaryFile     string `yaml:"summary_file"`
+	Transactions    string `yaml:"transactions"`
 }
 
 // Initialize - initializes basic framework settings
@@ -133,6 +264,7 @@ func (framework *Framework) CanExecuteMemoryIntensiveTestCase() bool {
 }
 
 // Initialize - initializes basic funding settings
+// The minimum funds of the network profile are only used as a default when no config layer (config.yml, env variables or flags) has set the minimum funds
 func (funding *Funding) Initialize() error {
 	if funding.RawMinimumFunds != "" {
 		decMinimumFunds, err := goSDKCommon.NewDecFromString(funding.RawMinimumFunds)
@@ -140,9 +272,9 @@ func (funding *Funding) Initialize() error {
 			return errors.Wrapf(err, "Funding: Minimum funds")
 		}
 		funding.MinimumFunds = decMinimumFunds
-	}
-
-	if Configuration.Network.Name == "localnet" {
+	} else if profile := Configuration.Network.Profile; profile != nil && !profile.MinimumFunds.IsNil() {
+		funding.MinimumFunds = profile.MinimumFunds
+	} else {
 		funding.MinimumFunds = numeric.NewDec(10)
 	}
 
@@ -150,9 +282,183 @@ func (funding *Funding) Initialize() error {
 		return err
 	}
 
+	if err := funding.Pool.Initialize(funding.MinimumFunds); err != nil {
+		return err
+	}
+
+	funding.Batch.Initialize()
+
 	return nil
 }
 
+// Initialize - initializes the funding pool settings, the threshold defaults to the minimum funds and the target defaults to twice the threshold
+func (pool *Pool) Initialize(minimumFunds numeric.Dec) error {
+	pool.Threshold = minimumFunds
+	if pool.RawThreshold != "" {
+		decThreshold, err := goSDKCommon.NewDecFromString(pool.RawThreshold)
+		if err != nil {
+			return errors.Wrapf(err, "Funding: Pool threshold")
+		}
+		pool.Threshold = decThreshold
+	}
+
+	if pool.Threshold.IsNil() {
+		pool.Threshold = numeric.NewDec(0)
+	}
+
+	pool.Target = pool.Threshold.Mul(numeric.NewDec(2))
+	if pool.RawTarget != "" {
+		decTarget, err := goSDKCommon.NewDecFromString(pool.RawTarget)
+		if err != nil {
+			return errors.Wrapf(err, "Funding: Pool target")
+		}
+		pool.Target = decTarget
+	}
+
+	if pool.Target.LT(pool.Threshold) {
+		return fmt.Errorf("Funding: Pool target %f can't be lower than the pool threshold %f", pool.Target, pool.Threshold)
+	}
+
+	if pool.Interval <= 0 {
+		pool.Interval = 30
+	}
+
+	return nil
+}
+
+// Enabled - whether or not the funding pool has been enabled
+func (pool *Pool) Enabled() bool {
+	return pool.Size > 1
+}
+
+// Initialize - initializes the batch funding settings
+func (batch *Batch) Initialize() {
+	if batch.MinimumCount <= 0 {
+		batch.MinimumCount = 10
+	}
+
+	if batch.GasLimit == 0 {
+		batch.GasLimit = 5000000
+	}
+
+	if batch.RecipientGas == 0 {
+		batch.RecipientGas = 40000
+	}
+}
+
+// ChunkSize - the maximum number of recipients that can be funded using a single distributor transaction
+func (batch *Batch) ChunkSize() int {
+	// 21000 intrinsic gas + the cost of the amount word
+	overhead := uint64(21000 + 32*68)
+	if batch.GasLimit <= overhead+batch.RecipientGas {
+		return 1
+	}
+
+	return int((batch.GasLimit - overhead) / batch.RecipientGas)
+}
+
+// Initialize - initializes and validates the notification sinks
+func (notifications *Notifications) Initialize() error {
+	for index := range notifications.Sinks {
+		sink := &notifications.Sinks[index]
+
+		sink.Type = strings.ToLower(sink.Type)
+		if sink.Name == "" {
+			sink.Name = fmt.Sprintf("%s-%d", sink.Type, index)
+		}
+
+		switch sink.Type {
+		case "webhook", "slack":
+			if sink.URL == "" {
+				return fmt.Errorf("Notifications: the %s sink %s requires a url", sink.Type, sink.Name)
+			}
+		case "smtp":
+			if sink.SMTP.Host == "" || sink.SMTP.From == "" || len(sink.SMTP.To) == 0 {
+				return fmt.Errorf("Notifications: the smtp sink %s requires a host, a from address and at least one to address", sink.Name)
+			}
+			if sink.SMTP.Port <= 0 {
+				sink.SMTP.Port = 25
+			}
+		default:
+			return fmt.Errorf("Notifications: the sink %s has an unknown type %q - valid types: webhook, slack, smtp", sink.Name, sink.Type)
+		}
+
+		if len(sink.Events) == 0 {
+			sink.Events = []string{"completed"}
+		}
+
+		if sink.Timeout <= 0 {
+			sink.Timeout = 10
+		}
+
+		if sink.TemplateFile != "" && !filepath.IsAbs(sink.TemplateFile) {
+			sink.TemplateFile = filepath.Join(Configuration.Framework.BasePath, sink.TemplateFile)
+		}
+	}
+
+	return nil
+}
+
+// Subscribes - whether or not the sink should be notified of a given event
+func (sink *NotificationSink) Subscribes(event string, failed bool) bool {
+	if sink.OnlyOnFailure && !failed {
+		return false
+	}
+
+	for _, subscribed := range sink.Events {
+		if strings.EqualFold(subscribed, event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Initialize - initializes the invariant checker settings
+func (invariants *Invariants) Initialize() error {
+	invariants.Tolerance = numeric.NewDec(0)
+
+	if invariants.RawTolerance != "" {
+		tolerance, err := goSDKCommon.NewDecFromString(invariants.RawTolerance)
+		if err != nil {
+			return errors.Wrapf(err, "Invariants: Tolerance")
+		}
+		invariants.Tolerance = tolerance
+	}
+
+	return nil
+}
+
+// Initialize - initializes the run history settings
+func (history *History) Initialize() {
+	if history.Path == "" {
+		history.Path = "history"
+	}
+
+	if !filepath.IsAbs(history.Path) {
+		history.Path = filepath.Join(Configuration.Framework.BasePath, history.Path)
+	}
+
+	if history.Runs <= 0 {
+		history.Runs = 10
+	}
+}
+
+// Initialize - initializes the teardown settings
+func (teardown *Teardown) Initialize() {
+	if teardown.Concurrency <= 0 {
+		teardown.Concurrency = 10
+	}
+
+	if teardown.Retry.Attempts <= 0 {
+		teardown.Retry.Attempts = 3
+	}
+
+	if teardown.Retry.Wait < 0 {
+		teardown.Retry.Wait = 0
+	}
+}
+
 // Initialize - initializes basic framework settings
 func (network *Network) Initialize() {
 	if network.RPCPrefix == "" {
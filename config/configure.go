@@ -1,8 +1,10 @@
 package config
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -17,9 +19,7 @@ import (
 	sdkNetworkTypes "github.com/harmony-one/go-lib/network/types/network"
 	sdkNetworkUtils "github.com/harmony-one/go-lib/network/utils"
 	goSdkCommon "github.com/harmony-one/go-sdk/pkg/common"
-	"github.com/harmony-one/harmony-tf/utils"
 	"github.com/mackerelio/go-osstat/memory"
-	"gopkg.in/yaml.v2"
 )
 
 // Configuration - the central configuration for the test suite tool
@@ -27,10 +27,9 @@ var (
 	Configuration Config
 )
 
-// Configure - configures the test suite tool using a combination of the YAML config file as well as command arguments
+// Configure - configures the test suite tool using the layered YAML config (see layers.go) as well as command arguments
 func Configure(basePath string) (err error) {
-	configPath := filepath.Join(basePath, "config.yml")
-	if err = loadYamlConfig(configPath); err != nil {
+	if err = LoadLayeredConfig(basePath); err != nil {
 		return err
 	}
 
@@ -48,12 +47,18 @@ func Configure(basePath string) (err error) {
 		return err
 	}
 
-	configureAccountConfig()
+	if err = configureAccountConfig(); err != nil {
+		return err
+	}
 
 	if err = configureFundingConfig(); err != nil {
 		return err
 	}
 
+	if err = configureSignerConfig(); err != nil {
+		return err
+	}
+
 	if err = configureExports(); err != nil {
 		return err
 	}
@@ -81,19 +86,26 @@ func ConfigureStylingConfig() {
 }
 
 func configureNetworkConfig() error {
-	if Args.Network != "" && Args.Network != Configuration.Network.Name {
-		Configuration.Network.Name = Args.Network
+	profile, err := FindNetworkProfile(Configuration.Network.Name)
+	if err != nil {
+		return err
 	}
+	Configuration.Network.Profile = profile
 
-	Configuration.Network.Name = sdkNetworkUtils.NormalizedNetworkName(Configuration.Network.Name)
-	if Configuration.Network.Name == "" {
-		return errors.New("you need to specify a valid network name to use! Valid options: localnet, devnet, testnet, staking, stressnet, mainnet")
+	// Networks that are only declared by a profile (e.g. custom devnets) aren't known to the SDK and have to use the profile endpoints
+	knownNetwork := sdkNetworkUtils.NormalizedNetworkName(Configuration.Network.Name)
+	switch {
+	case knownNetwork != "":
+		Configuration.Network.Name = knownNetwork
+	case profile != nil:
+		Configuration.Network.Name = profile.Name
+	default:
+		return fmt.Errorf("you need to specify a valid network name to use! Valid options: localnet, devnet, testnet, staking, stressnet, mainnet or any network with a profile in %s", NetworkProfilesPath())
 	}
 
 	Configuration.Network.Mode = strings.ToLower(Configuration.Network.Mode)
-	mode := strings.ToLower(Args.Mode)
-	if mode != "" && mode != Configuration.Network.Mode {
-		Configuration.Network.Mode = mode
+	if Configuration.Network.Mode == "" {
+		Configuration.Network.Mode = "api"
 	}
 
 	if len(Args.Nodes) > 0 {
@@ -105,6 +117,17 @@ func configureNetworkConfig() error {
 				break
 			}
 		}
+
+		if len(Configuration.Network.Nodes) == 0 && profile != nil {
+			Configuration.Network.Nodes = profile.Endpoints
+		}
+	}
+
+	if knownNetwork == "" {
+		if len(Configuration.Network.Nodes) == 0 {
+			return fmt.Errorf("the network profile %s doesn't declare any endpoints - add them to the profile or use --nodes", profile.Path)
+		}
+		Configuration.Network.Mode = "custom"
 	}
 
 	node := sdkNetworkUtils.ResolveStartingNode(Configuration.Network.Name, Configuration.Network.Mode, 0, Configuration.Network.Nodes)
@@ -137,12 +160,23 @@ func configureNetworkConfig() error {
 
 	Configuration.Network.API.Initialize()
 
+	if chainID := profile.ChainID("hmy", 0); chainID != nil {
+		Configuration.Network.API.ChainID = chainID
+	}
+
 	if Configuration.Network.API.ChainID == nil {
 		return errors.New("chain id must be set - please check that you are using correct network settings")
 	}
 
 	Configuration.Network.Shards = len(shardingStructure)
 
+	if profile != nil && profile.Shards > 0 && profile.Shards != Configuration.Network.Shards {
+		fmt.Printf("Warning: the network profile for %s expects %d shards but the network reports %d shards\n", Configuration.Network.Name, profile.Shards, Configuration.Network.Shards)
+	}
+
+	Configuration.Network.CrossShardTxWaitTime = profile.AdjustTimeout(Configuration.Network.CrossShardTxWaitTime)
+	Configuration.Network.StakingWaitTime = profile.AdjustTimeout(Configuration.Network.StakingWaitTime)
+
 	if err := Configuration.Network.Gas.Initialize(); err != nil {
 		return err
 	}
@@ -173,6 +207,12 @@ func configureFrameworkConfig() error {
 
 	Configuration.Framework.StartTime = time.Now().UTC()
 
+	// Every run gets unique derived accounts unless the run is configured explicitly (to reproduce a previous run)
+	if Configuration.Framework.Run == "" {
+		Configuration.Framework.Run = Configuration.Framework.StartTime.Format("20060102150405")
+		Configuration.Framework.GeneratedRun = true
+	}
+
 	testTarget := strings.ToLower(Args.TestTarget)
 	if testTarget != "" && testTarget != Configuration.Framework.Test {
 		Configuration.Framework.Test = testTarget
@@ -194,10 +234,122 @@ func configureFrameworkConfig() error {
 	return nil
 }
 
-func configureAccountConfig() {
-	if Args.Passphrase != "" && Args.Passphrase != Configuration.Account.Passphrase {
+func configureAccountConfig() error {
+	if Args.PassphraseFile != "" {
+		Configuration.Account.PassphraseFile = Args.PassphraseFile
+	}
+
+	switch {
+	case Args.Passphrase != "":
 		Configuration.Account.Passphrase = Args.Passphrase
+	case Args.PassStdin:
+		passphrase, err := readPassphrase(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read the passphrase from stdin - error: %s", err.Error())
+		}
+		Configuration.Account.Passphrase = passphrase
+	case Configuration.Account.PassphraseFile != "":
+		passphrase, err := readPassphraseFile(Configuration.Account.PassphraseFile)
+		if err != nil {
+			return err
+		}
+		Configuration.Account.Passphrase = passphrase
+	}
+
+	return configureKeySources()
+}
+
+func configureKeySources() error {
+	keys := &Configuration.Account.Keys
+
+	if len(Args.KeySources) > 0 {
+		keys.Sources = Args.KeySources
+	}
+
+	if len(keys.Sources) == 0 {
+		keys.Sources = []string{"file"}
+	}
+
+	for index, source := range keys.Sources {
+		keys.Sources[index] = strings.ToLower(strings.TrimSpace(source))
+	}
+
+	// The bundle is kept next to (not inside) keys/<network> since every file in that folder is treated as a keystore file
+	if keys.Bundle == "" {
+		keys.Bundle = filepath.Join("keys", fmt.Sprintf("%s.bundle", Configuration.Network.Name))
+	}
+
+	if !filepath.IsAbs(keys.Bundle) {
+		keys.Bundle = filepath.Join(Configuration.Framework.BasePath, keys.Bundle)
+	}
+
+	if keys.Env == "" {
+		keys.Env = "HARMONY_TF_PRIVATE_KEY"
+	}
+
+	return configureVault(&keys.Vault)
+}
+
+func configureVault(vault *Vault) error {
+	if vault.Address == "" {
+		vault.Address = os.Getenv("VAULT_ADDR")
+	}
+
+	if vault.Mount == "" {
+		vault.Mount = "secret"
+	}
+
+	if vault.Path == "" {
+		vault.Path = fmt.Sprintf("harmony-tf/%s", Configuration.Network.Name)
 	}
+
+	if vault.Field == "" {
+		vault.Field = "private_keys"
+	}
+
+	if vault.Version == 0 {
+		vault.Version = 2
+	}
+
+	if vault.Timeout == 0 {
+		vault.Timeout = 10
+	}
+
+	vault.Token = os.Getenv("VAULT_TOKEN")
+	if vault.TokenFile != "" {
+		token, err := readPassphraseFile(vault.TokenFile)
+		if err != nil {
+			return err
+		}
+		vault.Token = token
+	}
+
+	return nil
+}
+
+func readPassphraseFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	passphrase, err := readPassphrase(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s - error: %s", path, err.Error())
+	}
+
+	return passphrase, nil
+}
+
+// readPassphrase - reads the first line of a given reader, trailing new lines are stripped
+func readPassphrase(reader io.Reader) (string, error) {
+	line, err := bufio.NewReader(reader).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
 }
 
 func configureFundingConfig() error {
@@ -222,8 +374,8 @@ func configureFundingConfig() error {
 		}
 	}
 
-	if Args.MinimumFunds != "" && Args.MinimumFunds != Configuration.Funding.RawMinimumFunds {
-		Configuration.Funding.RawMinimumFunds = Args.MinimumFunds
+	if Args.BatchFunding {
+		Configuration.Funding.Batch.Enabled = true
 	}
 
 	if err := Configuration.Funding.Initialize(); err != nil {
@@ -234,31 +386,63 @@ func configureFundingConfig() error {
 		Configuration.Funding.Timeout = Configuration.Network.Timeout
 	}
 
+	Configuration.Teardown.Initialize()
+
+	if err := Configuration.Invariants.Initialize(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func configureSignerConfig() error {
+	Configuration.Signer.Type = strings.ToLower(Configuration.Signer.Type)
+	if Configuration.Signer.Type == "" {
+		Configuration.Signer.Type = "local"
+	}
+
+	if Configuration.Signer.Timeout == 0 {
+		Configuration.Signer.Timeout = 30
+	}
+
+	switch Configuration.Signer.Type {
+	case "local":
+		return nil
+	case "remote":
+		if Configuration.Signer.URL == "" {
+			return errors.New("the remote signer requires a signer url - set signer.url or use --signer-url")
+		}
+
+		if len(Configuration.Signer.Addresses) == 0 {
+			if Configuration.Funding.Account.Address == "" {
+				return errors.New("the remote signer requires a funding account address - set funding.account.address or use --address")
+			}
+			Configuration.Signer.Addresses = []string{Configuration.Funding.Account.Address}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown signer type %s - available signer types are: local, remote", Configuration.Signer.Type)
+	}
+}
+
 func configureExports() error {
 	Configuration.Export.Path = filepath.Join(Configuration.Framework.BasePath, Args.ExportPath)
 	if err := os.MkdirAll(Configuration.Export.Path, 0755); err != nil {
 		return err
 	}
 
-	if Args.Export != "" {
-		Configuration.Export.Format = Args.Export
+	if Args.RecordRawTxs {
+		Configuration.Export.RawTransactions = true
 	}
 
-	return nil
-}
-
-func loadYamlConfig(path string) error {
-	Configuration = Config{}
-	yamlData, err := utils.ReadFileToString(path)
-	if err != nil {
-		return err
+	if Configuration.Export.SummaryFile != "" && !filepath.IsAbs(Configuration.Export.SummaryFile) {
+		Configuration.Export.SummaryFile = filepath.Join(Configuration.Framework.BasePath, Configuration.Export.SummaryFile)
 	}
 
-	err = yaml.Unmarshal([]byte(yamlData), &Configuration)
-	if err != nil {
+	Configuration.History.Initialize()
+
+	if err := Configuration.Notifications.Initialize(); err != nil {
 		return err
 	}
 
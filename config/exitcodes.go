@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	// ExitSuccess - all executed test cases passed
+	ExitSuccess = 0
+
+	// ExitTestFailures - at least one test case failed
+	ExitTestFailures = 1
+
+	// ExitDismissedOnly - no test case failed but at least one test case was dismissed
+	ExitDismissedOnly = 2
+
+	// ExitConfigurationError - the framework, the network or the test cases couldn't be configured
+	ExitConfigurationError = 3
+
+	// ExitFundingError - the funding account or the funding pool couldn't be set up
+	ExitFundingError = 4
+)
+
+// ExitError - an error carrying the exit code the process should exit with
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error - the error message
+func (exitError *ExitError) Error() string {
+	return exitError.Err.Error()
+}
+
+// Unwrap - the underlying error
+func (exitError *ExitError) Unwrap() error {
+	return exitError.Err
+}
+
+// NewExitError - wraps an error with a given exit code, returns nil for nil errors
+func NewExitError(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &ExitError{Code: code, Err: err}
+}
+
+// ExitCode - the exit code for a given error, errors without an exit code are treated as configuration errors
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+
+	var exitError *ExitError
+	if errors.As(err, &exitError) {
+		return exitError.Code
+	}
+
+	return ExitConfigurationError
+}
+
+// Exit - prints a given error and exits using its exit code
+func Exit(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	}
+
+	os.Exit(ExitCode(err))
+}
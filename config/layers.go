@@ -0,0 +1,514 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	sdkNetworkUtils "github.com/harmony-one/go-lib/network/utils"
+	"github.com/harmony-one/harmony-tf/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Configuration layers are applied in the following order, later layers take precedence:
+//
+//  1. config.yml                   - the base configuration
+//  2. config.<network>.yml         - an optional per-network overlay (e.g. config.localnet.yml)
+//  3. HTF_<PATH> env variables     - e.g. HTF_NETWORK_TIMEOUT=60 or HTF_FUNDING_MINIMUM_FUNDS=10
+//  4. --set <path>=<value> flags   - e.g. --set network.timeout=60 --set funding.pool.size=4
+//  5. dedicated flags              - e.g. --network, --mode or --minimum-funds (only when explicitly set)
+const (
+	// EnvironmentPrefix - the prefix of environment variables overriding config keys
+	EnvironmentPrefix = "HTF_"
+
+	redacted = "<redacted>"
+)
+
+var (
+	// Layers - describes the configuration layers that have been applied
+	Layers []string
+
+	// EffectiveConfig - the merged configuration tree of all layers
+	EffectiveConfig yaml.MapSlice
+
+	// flagPaths - maps the dedicated flags to the config keys they override
+	flagPaths = map[string]string{
+		"network":          "network.name",
+		"mode":             "network.mode",
+		"minimum-funds":    "funding.minimum_funds",
+		"seed":             "framework.seed",
+		"run":              "framework.run",
+		"signer":           "signer.type",
+		"signer-url":       "signer.url",
+		"export":           "export.format",
+		"summary-file":     "export.summary_file",
+		"export-txs":       "export.transactions",
+		"check-invariants": "invariants.enabled",
+	}
+
+	// secretKeys - config keys containing any of these are redacted when the configuration is shown
+	secretKeys = []string{"passphrase", "password", "secret", "token", "private", "mnemonic", "seed"}
+
+	// secretPaths - config values that are redacted as a whole since they commonly embed credentials (e.g. webhook urls and auth headers)
+	secretPaths = []string{"notifications.sinks.url", "notifications.sinks.headers", "signer.url"}
+)
+
+// LoadLayeredConfig - loads config.yml and applies the network overlay, env variables and flag overrides on top of it
+func LoadLayeredConfig(basePath string) error {
+	configPath := filepath.Join(basePath, "config.yml")
+	if _, err := os.Stat(configPath); err != nil {
+		return fmt.Errorf("can't find the config file %s", configPath)
+	}
+
+	tree, err := readYamlTree(configPath)
+	if err != nil {
+		return err
+	}
+	Layers = []string{configPath}
+
+	if overlayPath := networkOverlayPath(basePath, tree); overlayPath != "" {
+		overlay, err := readYamlTree(overlayPath)
+		if err != nil {
+			return err
+		}
+		tree = mergeTrees(tree, overlay)
+		Layers = append(Layers, overlayPath)
+	}
+
+	overrides, err := environmentOverrides()
+	if err != nil {
+		return err
+	}
+
+	setOverrides, err := pathOverrides(Args.Overrides, "--set")
+	if err != nil {
+		return err
+	}
+	overrides = append(overrides, setOverrides...)
+	overrides = append(overrides, flagOverrides()...)
+
+	for _, override := range overrides {
+		tree = setTreeValue(tree, override.path, override.value)
+		Layers = append(Layers, override.source)
+	}
+
+	data, err := yaml.Marshal(tree)
+	if err != nil {
+		return err
+	}
+
+	Configuration = Config{}
+	if err := yaml.Unmarshal(data, &Configuration); err != nil {
+		return err
+	}
+
+	EffectiveConfig = tree
+
+	return nil
+}
+
+// RedactedConfig - the effective configuration tree with all secrets redacted
+func RedactedConfig() yaml.MapSlice {
+	return redactTree(EffectiveConfig, nil)
+}
+
+type override struct {
+	path   []string
+	value  interface{}
+	source string
+}
+
+// networkOverlayPath - the path of the per-network overlay, the network is resolved using the same precedence as the config layers
+func networkOverlayPath(basePath string, tree yaml.MapSlice) string {
+	network := ""
+	if value, ok := treeValue(tree, []string{"network", "name"}); ok && value != nil {
+		network = fmt.Sprintf("%v", value)
+	}
+	if value := os.Getenv(EnvironmentPrefix + "NETWORK_NAME"); value != "" {
+		network = value
+	}
+	for _, override := range Args.Overrides {
+		if strings.HasPrefix(override, "network.name=") {
+			network = strings.TrimPrefix(override, "network.name=")
+		}
+	}
+	if flagChanged("network") {
+		network = Args.Network
+	}
+
+	candidates := []string{strings.ToLower(network)}
+	if normalized := sdkNetworkUtils.NormalizedNetworkName(network); normalized != "" && normalized != candidates[0] {
+		candidates = append(candidates, normalized)
+	}
+
+	for _, candidate := range candidates {
+		path := filepath.Join(basePath, fmt.Sprintf("config.%s.yml", candidate))
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// environmentOverrides - converts HTF_ prefixed env variables to overrides, underscores separate keys but are also allowed within keys (e.g. HTF_FUNDING_MINIMUM_FUNDS)
+func environmentOverrides() (overrides []override, err error) {
+	environment := os.Environ()
+	sort.Strings(environment)
+
+	for _, variable := range environment {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], EnvironmentPrefix) {
+			continue
+		}
+
+		// CI environments often carry unrelated HTF_ variables, those are ignored rather than treated as configuration errors
+		segments := strings.Split(strings.ToLower(strings.TrimPrefix(parts[0], EnvironmentPrefix)), "_")
+		path, ok := resolvePath(reflect.TypeOf(Config{}), segments, true)
+		if !ok {
+			fmt.Printf("Warning: ignoring the environment variable %s since it doesn't map to a config key\n", parts[0])
+			continue
+		}
+
+		value, err := parseValue(parts[1], path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for the environment variable %s - %s", parts[0], err.Error())
+		}
+
+		overrides = append(overrides, override{path: path, value: value, source: fmt.Sprintf("env %s", parts[0])})
+	}
+
+	return overrides, nil
+}
+
+// pathOverrides - converts <path>=<value> pairs (e.g. network.timeout=60) to overrides
+func pathOverrides(pairs []string, source string) (overrides []override, err error) {
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid config override %q - use <path>=<value>, e.g. network.timeout=60", pair)
+		}
+
+		path, ok := resolvePath(reflect.TypeOf(Config{}), strings.Split(strings.ToLower(parts[0]), "."), false)
+		if !ok {
+			return nil, fmt.Errorf("invalid config override %q - %s isn't a config key", pair, parts[0])
+		}
+
+		value, err := parseValue(parts[1], path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config override %q - %s", pair, err.Error())
+		}
+
+		overrides = append(overrides, override{path: path, value: value, source: fmt.Sprintf("%s %s", source, parts[0])})
+	}
+
+	return overrides, nil
+}
+
+// flagOverrides - converts explicitly set dedicated flags to overrides
+func flagOverrides() (overrides []override) {
+	names := []string{}
+	for name := range flagPaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !flagChanged(name) {
+			continue
+		}
+
+		flag := RootCommand.PersistentFlags().Lookup(name)
+		path := strings.Split(flagPaths[name], ".")
+
+		// The flag values have already been validated by their flag types
+		value, err := parseValue(flag.Value.String(), path)
+		if err != nil {
+			value = flag.Value.String()
+		}
+
+		overrides = append(overrides, override{path: path, value: value, source: fmt.Sprintf("--%s", name)})
+	}
+
+	return overrides
+}
+
+func flagChanged(name string) bool {
+	flag := RootCommand.PersistentFlags().Lookup(name)
+	return flag != nil && flag.Changed
+}
+
+// resolvePath - resolves key segments to a config key path using the yaml keys of the config types
+// When joinable is set, consecutive segments can form a single key (e.g. minimum + funds = minimum_funds) - the longest matching key wins
+func resolvePath(t reflect.Type, segments []string, joinable bool) ([]string, bool) {
+	if len(segments) == 0 {
+		return nil, true
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	longest := 1
+	if joinable {
+		longest = len(segments)
+	}
+
+	for length := longest; length >= 1; length-- {
+		key := strings.Join(segments[:length], "_")
+
+		var childType reflect.Type
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := yamlField(t, key)
+			if !ok {
+				continue
+			}
+			childType = field.Type
+		case reflect.Map:
+			childType = t.Elem()
+		default:
+			return nil, false
+		}
+
+		if rest, ok := resolvePath(childType, segments[length:], joinable); ok {
+			return append([]string{key}, rest...), true
+		}
+	}
+
+	return nil, false
+}
+
+// yamlField - finds the struct field using a given yaml key, following the key naming rules of yaml.v2
+func yamlField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		if name == key {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// parseValue - converts a raw override value using the type of the config key it targets
+// Strings are used as is (e.g. "000123" or "0x10" stay strings), other types (numbers, booleans and lists like [a, b]) are parsed as YAML into the target type
+func parseValue(raw string, path []string) (interface{}, error) {
+	t, ok := pathType(reflect.TypeOf(Config{}), path)
+	if !ok {
+		return raw, nil
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.String {
+		return raw, nil
+	}
+
+	value := reflect.New(t)
+	if err := yaml.Unmarshal([]byte(raw), value.Interface()); err != nil {
+		return nil, fmt.Errorf("%s expects a value of type %s, got %q", strings.Join(path, "."), t.String(), raw)
+	}
+
+	return value.Elem().Interface(), nil
+}
+
+// pathType - the type of the config key at a given (resolved) key path
+func pathType(t reflect.Type, path []string) (reflect.Type, bool) {
+	for _, key := range path {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := yamlField(t, key)
+			if !ok {
+				return nil, false
+			}
+			t = field.Type
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, false
+		}
+	}
+
+	return t, true
+}
+
+func readYamlTree(path string) (yaml.MapSlice, error) {
+	data, err := utils.ReadFileToString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := yaml.MapSlice{}
+	if err := yaml.Unmarshal([]byte(data), &tree); err != nil {
+		return nil, fmt.Errorf("failed to parse %s - error: %s", path, err.Error())
+	}
+
+	return tree, nil
+}
+
+// mergeTrees - deep merges an overlay into a base tree, overlay values replace base values except for nested mappings which are merged
+func mergeTrees(base yaml.MapSlice, overlay yaml.MapSlice) yaml.MapSlice {
+	for _, item := range overlay {
+		index := treeIndex(base, item.Key)
+		if index < 0 {
+			base = append(base, item)
+			continue
+		}
+
+		baseChild, baseIsTree := base[index].Value.(yaml.MapSlice)
+		overlayChild, overlayIsTree := item.Value.(yaml.MapSlice)
+		if baseIsTree && overlayIsTree {
+			base[index].Value = mergeTrees(baseChild, overlayChild)
+		} else {
+			base[index].Value = item.Value
+		}
+	}
+
+	return base
+}
+
+func setTreeValue(tree yaml.MapSlice, path []string, value interface{}) yaml.MapSlice {
+	index := treeIndex(tree, path[0])
+	if index < 0 {
+		tree = append(tree, yaml.MapItem{Key: path[0]})
+		index = len(tree) - 1
+	}
+
+	if len(path) == 1 {
+		tree[index].Value = value
+		return tree
+	}
+
+	child, _ := tree[index].Value.(yaml.MapSlice)
+	tree[index].Value = setTreeValue(child, path[1:], value)
+
+	return tree
+}
+
+func treeValue(tree yaml.MapSlice, path []string) (interface{}, bool) {
+	index := treeIndex(tree, path[0])
+	if index < 0 {
+		return nil, false
+	}
+
+	if len(path) == 1 {
+		return tree[index].Value, true
+	}
+
+	child, ok := tree[index].Value.(yaml.MapSlice)
+	if !ok {
+		return nil, false
+	}
+
+	return treeValue(child, path[1:])
+}
+
+func treeIndex(tree yaml.MapSlice, key interface{}) int {
+	for index, item := range tree {
+		if fmt.Sprintf("%v", item.Key) == fmt.Sprintf("%v", key) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func redactTree(tree yaml.MapSlice, path []string) yaml.MapSlice {
+	redactedTree := yaml.MapSlice{}
+
+	for _, item := range tree {
+		itemPath := append(append([]string{}, path...), fmt.Sprintf("%v", item.Key))
+		redactedTree = append(redactedTree, yaml.MapItem{Key: item.Key, Value: redactValue(item.Value, itemPath)})
+	}
+
+	return redactedTree
+}
+
+// redactValue - redacts a value of the tree, list items share the path of the list (e.g. notifications.sinks.url)
+func redactValue(value interface{}, path []string) interface{} {
+	if isSecretPath(path) {
+		if isEmptyValue(value) {
+			return value
+		}
+		return redacted
+	}
+
+	switch typed := value.(type) {
+	case yaml.MapSlice:
+		return redactTree(typed, path)
+	case []interface{}:
+		redactedList := []interface{}{}
+		for _, item := range typed {
+			redactedList = append(redactedList, redactValue(item, path))
+		}
+		return redactedList
+	default:
+		if isSecretKey(path[len(path)-1]) && !isEmptyValue(value) {
+			return redacted
+		}
+	}
+
+	return value
+}
+
+func isSecretPath(path []string) bool {
+	joined := strings.ToLower(strings.Join(path, "."))
+
+	for _, secret := range secretPaths {
+		if joined == secret {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case yaml.MapSlice:
+		return len(typed) == 0
+	case []interface{}:
+		return len(typed) == 0
+	default:
+		return fmt.Sprintf("%v", value) == ""
+	}
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "_file") {
+		return false
+	}
+
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+
+	return false
+}
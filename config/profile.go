@@ -0,0 +1,213 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	goSDKCommon "github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/utils"
+	"github.com/harmony-one/harmony/numeric"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// NetworkProfile - represents a network profile file (networks/<name>.yml) declaring the network specific settings
+// Custom networks can be added by dropping a new profile into the networks folder
+type NetworkProfile struct {
+	Name                   string                    `yaml:"name"`
+	Aliases                []string                  `yaml:"aliases"`
+	Endpoints              []string                  `yaml:"endpoints"`
+	ChainIDs               map[string]ProfileChainID `yaml:"chain_ids"`
+	Shards                 int                       `yaml:"shards"`
+	EpochLength            uint64                    `yaml:"epoch_length"`
+	BlockTime              uint64                    `yaml:"block_time"`
+	UndelegationLockEpochs uint64                    `yaml:"undelegation_lock_epochs"`
+	TimeoutMultiplier      float64                   `yaml:"timeout_multiplier"`
+	RawMinimumFunds        string                    `yaml:"minimum_funds"`
+	Explorer               string                    `yaml:"explorer"`
+	MinimumFunds           numeric.Dec               `yaml:"-"`
+	Path                   string                    `yaml:"-"`
+}
+
+// ProfileChainID - the chain id used for a given rpc prefix, per shard chain ids are calculated as id + shard id
+type ProfileChainID struct {
+	Name     string `yaml:"name"`
+	ID       int64  `yaml:"id"`
+	PerShard bool   `yaml:"per_shard"`
+}
+
+// NetworkProfilesPath - the folder containing the network profiles
+func NetworkProfilesPath() string {
+	return filepath.Join(Configuration.Framework.BasePath, "networks")
+}
+
+// LoadNetworkProfiles - loads all network profiles from the networks folder
+func LoadNetworkProfiles() (profiles []*NetworkProfile, err error) {
+	path := NetworkProfilesPath()
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return profiles, nil
+		}
+		return nil, err
+	}
+
+	for _, file := range files {
+		extension := filepath.Ext(file.Name())
+		if file.IsDir() || (extension != ".yml" && extension != ".yaml") {
+			continue
+		}
+
+		profile, err := LoadNetworkProfile(filepath.Join(path, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+// LoadNetworkProfile - loads and validates a network profile from a given path
+func LoadNetworkProfile(path string) (*NetworkProfile, error) {
+	yamlData, err := utils.ReadFileToString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &NetworkProfile{Path: path}
+	if err := yaml.Unmarshal([]byte(yamlData), profile); err != nil {
+		return nil, errors.Wrapf(err, "Network profile %s", path)
+	}
+
+	if err := profile.Initialize(); err != nil {
+		return nil, errors.Wrapf(err, "Network profile %s", path)
+	}
+
+	return profile, nil
+}
+
+// FindNetworkProfile - finds the network profile matching a given network name or alias
+func FindNetworkProfile(name string) (*NetworkProfile, error) {
+	profiles, err := LoadNetworkProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		if profile.Matches(name) {
+			return profile, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Initialize - initializes and validates the profile settings
+func (profile *NetworkProfile) Initialize() error {
+	profile.Name = strings.ToLower(profile.Name)
+	if profile.Name == "" {
+		profile.Name = strings.TrimSuffix(filepath.Base(profile.Path), filepath.Ext(profile.Path))
+	}
+
+	if profile.TimeoutMultiplier < 0 {
+		return fmt.Errorf("timeout_multiplier can't be negative")
+	}
+
+	if profile.Shards > 0 && len(profile.Endpoints) > 0 && len(profile.Endpoints) != profile.Shards {
+		return fmt.Errorf("%d endpoints have been declared for a network with %d shards", len(profile.Endpoints), profile.Shards)
+	}
+
+	if profile.RawMinimumFunds != "" {
+		minimumFunds, err := goSDKCommon.NewDecFromString(profile.RawMinimumFunds)
+		if err != nil {
+			return errors.Wrapf(err, "minimum_funds")
+		}
+		profile.MinimumFunds = minimumFunds
+	}
+
+	return nil
+}
+
+// Matches - whether or not the profile matches a given network name or alias
+func (profile *NetworkProfile) Matches(name string) bool {
+	name = strings.ToLower(name)
+	if name == profile.Name {
+		return true
+	}
+
+	for _, alias := range profile.Aliases {
+		if name == strings.ToLower(alias) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChainID - the chain id the profile declares for a given rpc prefix and shard, nil if the profile doesn't declare one
+func (profile *NetworkProfile) ChainID(rpcPrefix string, shardID uint32) *goSDKCommon.ChainID {
+	if profile == nil {
+		return nil
+	}
+
+	chainID, ok := profile.ChainIDs[rpcPrefix]
+	if !ok {
+		return nil
+	}
+
+	value := big.NewInt(chainID.ID)
+	if chainID.PerShard {
+		value = value.Add(value, big.NewInt(int64(shardID)))
+	}
+
+	name := chainID.Name
+	if name == "" {
+		name = fmt.Sprintf("%s_%s", rpcPrefix, profile.Name)
+	}
+
+	return &goSDKCommon.ChainID{Name: name, Value: value}
+}
+
+// AdjustTimeout - applies the profile timeout multiplier to a given timeout (in seconds)
+func (profile *NetworkProfile) AdjustTimeout(timeout uint32) uint32 {
+	if profile == nil || profile.TimeoutMultiplier == 0 || timeout == 0 {
+		return timeout
+	}
+
+	return uint32(math.RoundToEven(float64(timeout) * profile.TimeoutMultiplier))
+}
+
+// ExplorerURL - the explorer link for a given tx, {hash} and {shard} in the profile's explorer url are replaced with the tx hash and shard id
+func (profile *NetworkProfile) ExplorerURL(hash string, shardID uint32) string {
+	if profile == nil || profile.Explorer == "" || hash == "" {
+		return ""
+	}
+
+	return strings.NewReplacer("{hash}", hash, "{shard}", fmt.Sprintf("%d", shardID)).Replace(profile.Explorer)
+}
+
+// UndelegationReleaseEpoch - the epoch in which an undelegation made in a given epoch is released, 0 if the profile doesn't declare the undelegation lock period
+func (profile *NetworkProfile) UndelegationReleaseEpoch(epoch uint64) uint64 {
+	if profile == nil || profile.UndelegationLockEpochs == 0 {
+		return 0
+	}
+
+	return epoch + profile.UndelegationLockEpochs
+}
+
+// EpochWaitTime - the time (in seconds) to wait for the next epoch, falls back to a given default if the profile doesn't declare an epoch length and a block time
+func (profile *NetworkProfile) EpochWaitTime(defaultWaitTime uint32) uint32 {
+	if profile == nil || profile.EpochLength == 0 || profile.BlockTime == 0 {
+		return defaultWaitTime
+	}
+
+	return profile.AdjustTimeout(uint32(profile.EpochLength * profile.BlockTime))
+}
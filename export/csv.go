@@ -25,6 +25,7 @@ var (
 		"Started At",
 		"Finished At",
 		"Duration",
+		"Rejections",
 	}
 )
 
@@ -107,6 +108,7 @@ func csvRow(testCase *testing.TestCase) []string {
 		startedAtString,
 		finishedAtString,
 		durationString,
+		testCase.RejectionMessage(),
 	}
 }
 
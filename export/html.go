@@ -0,0 +1,330 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+	"gopkg.in/yaml.v2"
+)
+
+// htmlReport - the data rendered by the html report template
+type htmlReport struct {
+	Title      string
+	Network    string
+	Mode       string
+	Nodes      string
+	Version    string
+	Commit     string
+	StartedAt  string
+	Duration   string
+	Successful int
+	Failed     int
+	Dismissed  int
+	PassRate   string
+	TestCases  []htmlTestCase
+	Config     string
+}
+
+type htmlTestCase struct {
+	Index        int
+	Category     string
+	Name         string
+	Goal         string
+	Scenario     string
+	Status       string
+	Expected     string
+	Result       string
+	StartedAt    string
+	Duration     string
+	Seconds      float64
+	Offset       float64
+	Width        float64
+	Error        string
+	Dismissal    string
+	Rejections   []string
+	Transactions []htmlTransaction
+}
+
+type htmlTransaction struct {
+	Hash      string
+	Explorer  string
+	From      string
+	FromShard uint32
+	To        string
+	ToShard   uint32
+	Amount    string
+	Success   bool
+	Error     string
+}
+
+// ExportHTML - exports test suite results as a self-contained html report
+func ExportHTML(results []*testing.TestCase, dismissed []*testing.TestCase, successfulCount int, failedCount int, totalDuration time.Duration) (string, error) {
+	report := htmlReport{
+		Title:      fmt.Sprintf("Harmony TF report - %s - %s", strings.Title(config.Configuration.Network.Name), config.Configuration.Framework.StartTime.Format(timeFormat)),
+		Network:    config.Configuration.Network.Name,
+		Mode:       config.Configuration.Network.Mode,
+		Nodes:      strings.Join(config.Configuration.Network.Nodes, ", "),
+		Version:    config.Configuration.Framework.Version,
+		Commit:     config.Commit,
+		StartedAt:  config.Configuration.Framework.StartTime.Format(timeFormat),
+		Duration:   totalDuration.Round(time.Second).String(),
+		Successful: successfulCount,
+		Failed:     failedCount,
+		Dismissed:  len(dismissed),
+		PassRate:   "n/a",
+	}
+
+	if executed := successfulCount + failedCount; executed > 0 {
+		report.PassRate = fmt.Sprintf("%.1f%%", float64(successfulCount)/float64(executed)*100)
+	}
+
+	for _, testCase := range results {
+		report.TestCases = append(report.TestCases, htmlTestCaseFor(len(report.TestCases), testCase, testCase.Status()))
+	}
+
+	for _, testCase := range dismissed {
+		report.TestCases = append(report.TestCases, htmlTestCaseFor(len(report.TestCases), testCase, "Dismissed"))
+	}
+
+	timeline(report.TestCases, results)
+
+	if effectiveConfig, err := yaml.Marshal(config.RedactedConfig()); err == nil {
+		report.Config = string(effectiveConfig)
+	}
+
+	return writeHTMLToFile(report)
+}
+
+func htmlTestCaseFor(index int, testCase *testing.TestCase, status string) htmlTestCase {
+	htmlCase := htmlTestCase{
+		Index:      index,
+		Category:   testCase.Category,
+		Name:       testCase.Name,
+		Goal:       testCase.Goal,
+		Scenario:   testCase.Scenario,
+		Status:     status,
+		Expected:   testCase.ExpectedMessage(),
+		Result:     testCase.ResultMessage(),
+		Error:      testCase.ErrorMessage(),
+		Dismissal:  testCase.Dismissal,
+		Rejections: testCase.Rejections,
+	}
+
+	if !testCase.StartedAt.IsZero() {
+		htmlCase.StartedAt = testCase.StartedAt.Format(timeFormat)
+	}
+
+	if duration := testCase.Duration(); duration > 0 {
+		htmlCase.Duration = duration.Round(time.Millisecond).String()
+		htmlCase.Seconds = duration.Seconds()
+	}
+
+	if status == "Dismissed" && htmlCase.Dismissal == "" && !testCase.Execute {
+		htmlCase.Dismissal = "The test case has the execute attribute set to false"
+	}
+
+	for _, tx := range testCase.Transactions {
+		htmlCase.Transactions = append(htmlCase.Transactions, htmlTransactionFor(tx))
+	}
+
+	return htmlCase
+}
+
+func htmlTransactionFor(tx sdkTxs.Transaction) htmlTransaction {
+	htmlTx := htmlTransaction{
+		Hash:      tx.TransactionHash,
+		Explorer:  config.Configuration.Network.Profile.ExplorerURL(tx.TransactionHash, tx.FromShardID),
+		From:      tx.FromAddress,
+		FromShard: tx.FromShardID,
+		To:        tx.ToAddress,
+		ToShard:   tx.ToShardID,
+		Success:   tx.Success,
+	}
+
+	if !tx.Amount.IsNil() {
+		htmlTx.Amount = tx.Amount.String()
+	}
+
+	if tx.Error != nil {
+		htmlTx.Error = tx.Error.Error()
+	}
+
+	return htmlTx
+}
+
+// timeline - positions every executed test case on the timing chart as a percentage of the total suite runtime
+func timeline(htmlCases []htmlTestCase, results []*testing.TestCase) {
+	start := config.Configuration.Framework.StartTime
+	end := config.Configuration.Framework.EndTime
+	for _, testCase := range results {
+		if testCase.FinishedAt.After(end) {
+			end = testCase.FinishedAt
+		}
+	}
+
+	total := end.Sub(start).Seconds()
+	if total <= 0 {
+		return
+	}
+
+	for index, testCase := range results {
+		if testCase.StartedAt.IsZero() || testCase.FinishedAt.IsZero() {
+			continue
+		}
+
+		htmlCases[index].Offset = testCase.StartedAt.Sub(start).Seconds() / total * 100
+		htmlCases[index].Width = testCase.Duration().Seconds() / total * 100
+		if htmlCases[index].Width < 0.5 {
+			htmlCases[index].Width = 0.5
+		}
+	}
+}
+
+func writeHTMLToFile(report htmlReport) (string, error) {
+	fileName := generateFileName(config.Configuration.Framework.StartTime, "html")
+	filePath := filepath.Join(config.Configuration.Export.Path, fileName)
+	file, err := os.Create(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if err := htmlTemplate.Execute(file, report); err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.5em; } h2 { font-size: 1.2em; margin-top: 2em; }
+.cards { display: flex; flex-wrap: wrap; gap: 1em; }
+.card { border: 1px solid #ddd; border-radius: 6px; padding: 0.8em 1.2em; min-width: 8em; }
+.card .value { font-size: 1.6em; font-weight: bold; }
+.success { color: #1a7f37; } .failed { color: #cf222e; } .dismissed { color: #9a6700; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9em; }
+th, td { border-bottom: 1px solid #eee; padding: 0.4em 0.6em; text-align: left; vertical-align: top; }
+th.sortable { cursor: pointer; user-select: none; } th.sortable:after { content: " \2195"; color: #999; }
+tr.testcase:hover { background: #f6f8fa; }
+details { margin: 0.5em 0 1em 0; } summary { cursor: pointer; font-weight: bold; }
+.detail { border-left: 3px solid #ddd; padding-left: 1em; margin: 0.5em 0; }
+.mono { font-family: SFMono-Regular, Consolas, monospace; font-size: 0.85em; word-break: break-all; }
+.error { background: #ffebe9; padding: 0.4em; border-radius: 4px; }
+.chart { position: relative; }
+.chart .row { display: flex; align-items: center; height: 1.3em; font-size: 0.8em; }
+.chart .label { width: 30%; overflow: hidden; white-space: nowrap; text-overflow: ellipsis; padding-right: 0.5em; }
+.chart .track { position: relative; flex: 1; height: 0.9em; background: #f6f8fa; }
+.chart .bar { position: absolute; height: 100%; border-radius: 2px; }
+.bar.success { background: #2da44e; } .bar.failed { background: #cf222e; }
+pre { background: #f6f8fa; padding: 1em; overflow-x: auto; font-size: 0.85em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Network: <b>{{.Network}}</b> ({{.Mode}} mode) - Nodes: <span class="mono">{{.Nodes}}</span><br>
+Harmony TF v{{.Version}} ({{.Commit}}) - started at {{.StartedAt}}, took {{.Duration}}</p>
+
+<div class="cards">
+<div class="card"><div>Pass rate</div><div class="value">{{.PassRate}}</div></div>
+<div class="card"><div>Successful</div><div class="value success">{{.Successful}}</div></div>
+<div class="card"><div>Failed</div><div class="value failed">{{.Failed}}</div></div>
+<div class="card"><div>Dismissed</div><div class="value dismissed">{{.Dismissed}}</div></div>
+</div>
+
+<h2>Test cases</h2>
+<table id="testcases">
+<thead><tr>
+<th class="sortable" data-type="number">#</th>
+<th class="sortable">Category</th>
+<th class="sortable">Name</th>
+<th class="sortable">Status</th>
+<th class="sortable">Expected</th>
+<th class="sortable">Result</th>
+<th class="sortable">Started at</th>
+<th class="sortable" data-type="number">Duration</th>
+<th class="sortable" data-type="number">Txs</th>
+</tr></thead>
+<tbody>
+{{range .TestCases}}<tr class="testcase">
+<td data-value="{{.Index}}">{{.Index}}</td>
+<td>{{.Category}}</td>
+<td><a href="#testcase-{{.Index}}">{{.Name}}</a></td>
+<td class="{{lower .Status}}">{{.Status}}</td>
+<td>{{.Expected}}</td>
+<td>{{.Result}}</td>
+<td>{{.StartedAt}}</td>
+<td data-value="{{.Seconds}}">{{.Duration}}</td>
+<td data-value="{{len .Transactions}}">{{len .Transactions}}</td>
+</tr>
+{{end}}</tbody>
+</table>
+
+<h2>Timing</h2>
+<div class="chart">
+{{range .TestCases}}{{if gt .Width 0.0}}<div class="row"><div class="label" title="{{.Name}}">{{.Name}}</div><div class="track"><div class="bar {{lower .Status}}" style="left: {{printf "%.2f" .Offset}}%; width: {{printf "%.2f" .Width}}%" title="{{.Name}}: {{.Duration}}"></div></div></div>
+{{end}}{{end}}</div>
+
+<h2>Details</h2>
+{{range .TestCases}}<details id="testcase-{{.Index}}"{{if eq .Status "Failed"}} open{{end}}>
+<summary class="{{lower .Status}}">{{.Name}} - {{.Status}}</summary>
+<div class="detail">
+<p>{{.Goal}}<br>Scenario: <span class="mono">{{.Scenario}}</span>{{if .Duration}} - took {{.Duration}}{{end}}</p>
+{{if .Dismissal}}<p class="dismissed">Dismissed: {{.Dismissal}}</p>{{end}}
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{if .Rejections}}<p>Rejections:</p><ul>{{range .Rejections}}<li class="mono">{{.}}</li>{{end}}</ul>{{end}}
+{{if .Transactions}}<table>
+<thead><tr><th>Tx hash</th><th>From</th><th>To</th><th>Amount</th><th>Success</th><th>Error</th></tr></thead>
+<tbody>
+{{range .Transactions}}<tr>
+<td class="mono">{{if .Explorer}}<a href="{{.Explorer}}" target="_blank" rel="noopener">{{.Hash}}</a>{{else}}{{.Hash}}{{end}}</td>
+<td class="mono">{{.From}} (shard {{.FromShard}})</td>
+<td class="mono">{{.To}} (shard {{.ToShard}})</td>
+<td>{{.Amount}}</td>
+<td class="{{if .Success}}success{{else}}failed{{end}}">{{.Success}}</td>
+<td class="mono">{{.Error}}</td>
+</tr>
+{{end}}</tbody>
+</table>{{else}}<p>No transactions recorded.</p>{{end}}
+</div>
+</details>
+{{end}}
+
+<h2>Effective configuration</h2>
+<pre>{{.Config}}</pre>
+
+<script>
+document.querySelectorAll("#testcases th.sortable").forEach(function (header, column) {
+  var ascending = true;
+  header.addEventListener("click", function () {
+    var body = document.querySelector("#testcases tbody");
+    var rows = Array.prototype.slice.call(body.querySelectorAll("tr"));
+    var numeric = header.getAttribute("data-type") === "number";
+    rows.sort(function (a, b) {
+      var x = a.children[column].getAttribute("data-value") || a.children[column].textContent;
+      var y = b.children[column].getAttribute("data-value") || b.children[column].textContent;
+      var result = numeric ? (parseFloat(x) || 0) - (parseFloat(y) || 0) : x.localeCompare(y);
+      return ascending ? result : -result;
+    });
+    ascending = !ascending;
+    rows.forEach(function (row) { body.appendChild(row); });
+  });
+});
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// ExportSummary - writes a concise markdown summary of the run to the summary file (suitable for CI job summaries)
+func ExportSummary(results []*testing.TestCase, dismissed []*testing.TestCase, successfulCount int, failedCount int, totalDuration time.Duration) (string, error) {
+	path := config.Configuration.Export.SummaryFile
+	if path == "" {
+		return "", nil
+	}
+
+	var builder strings.Builder
+
+	status := ":white_check_mark: passed"
+	if failedCount > 0 {
+		status = ":x: failed"
+	} else if len(dismissed) > 0 {
+		status = ":warning: passed with dismissed test cases"
+	}
+
+	fmt.Fprintf(&builder, "## Harmony TF v%s - %s (%s mode): %s\n\n", config.Configuration.Framework.Version, config.Configuration.Network.Name, config.Configuration.Network.Mode, status)
+	builder.WriteString("| Executed | Successful | Failed | Dismissed | Duration |\n")
+	builder.WriteString("| ---: | ---: | ---: | ---: | ---: |\n")
+	fmt.Fprintf(&builder, "| %d | %d | %d | %d | %v |\n", len(results), successfulCount, failedCount, len(dismissed), totalDuration.Round(time.Second))
+
+	if failedCount > 0 {
+		builder.WriteString("\n### Failed test cases\n\n")
+		builder.WriteString("| Test case | Category | Reason |\n")
+		builder.WriteString("| --- | --- | --- |\n")
+		for _, testCase := range results {
+			if !testCase.Successful() {
+				fmt.Fprintf(&builder, "| %s | %s | %s |\n", markdownCell(testCase.Name), markdownCell(testCase.Category), markdownCell(failureReason(testCase)))
+			}
+		}
+	}
+
+	if len(dismissed) > 0 {
+		builder.WriteString("\n### Dismissed test cases\n\n")
+		builder.WriteString("| Test case | Category | Reason |\n")
+		builder.WriteString("| --- | --- | --- |\n")
+		for _, testCase := range dismissed {
+			fmt.Fprintf(&builder, "| %s | %s | %s |\n", markdownCell(testCase.Name), markdownCell(testCase.Category), markdownCell(testCase.Dismissal))
+		}
+	}
+
+	if err := ioutil.WriteFile(path, []byte(builder.String()), 0644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ExportAbortedSummary - writes the summary of a run that was aborted before (or instead of) executing the test cases, e.g. due to a configuration or funding error
+func ExportAbortedSummary(abortErr error) (string, error) {
+	path := config.Configuration.Export.SummaryFile
+	if path == "" || abortErr == nil {
+		return "", nil
+	}
+
+	// The summary file path is only resolved once the exports have been configured - resolve it here in case the configuration failed before that
+	if !filepath.IsAbs(path) && config.Configuration.Framework.BasePath != "" {
+		path = filepath.Join(config.Configuration.Framework.BasePath, path)
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "## Harmony TF v%s - %s (%s mode): :x: aborted\n\n", config.Configuration.Framework.Version, config.Configuration.Network.Name, config.Configuration.Network.Mode)
+	builder.WriteString("| Exit code | Reason |\n")
+	builder.WriteString("| ---: | --- |\n")
+	fmt.Fprintf(&builder, "| %d (%s) | %s |\n", config.ExitCode(abortErr), exitReason(config.ExitCode(abortErr)), markdownCell(abortErr.Error()))
+
+	if err := ioutil.WriteFile(path, []byte(builder.String()), 0644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// exitReason - a short description of an exit code
+func exitReason(code int) string {
+	switch code {
+	case config.ExitConfigurationError:
+		return "configuration error"
+	case config.ExitFundingError:
+		return "funding error"
+	case config.ExitTestFailures:
+		return "test failures"
+	case config.ExitDismissedOnly:
+		return "dismissed test cases"
+	default:
+		return "error"
+	}
+}
+
+// failureReason - the error of a failed test case, falls back to the node rejections and the expected vs actual result
+func failureReason(testCase *testing.TestCase) string {
+	if message := testCase.ErrorMessage(); message != "" {
+		return message
+	}
+
+	reason := fmt.Sprintf("expected %s, got %s", testCase.ExpectedMessage(), testCase.ResultMessage())
+	if len(testCase.Rejections) > 0 {
+		reason = fmt.Sprintf("%s - rejections: %s", reason, strings.Join(testCase.Rejections, "; "))
+	}
+
+	return reason
+}
+
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	value = strings.ReplaceAll(value, "\r", "")
+	value = strings.ReplaceAll(value, "\n", "<br>")
+
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
@@ -0,0 +1,250 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony-tf/utils"
+)
+
+var transactionHeaderRow = []string{
+	"Test Case",
+	"Category",
+	"Type",
+	"Transaction Hash",
+	"From Address",
+	"From Shard",
+	"To Address",
+	"To Shard",
+	"Amount",
+	"Nonce",
+	"Gas Limit",
+	"Gas Price",
+	"Gas Used",
+	"Block Number",
+	"Success",
+	"Error",
+	"Submitted At",
+	"Confirmed At",
+}
+
+// TransactionRecord - a transaction sent by a test case, nonce, gas and timestamps are only available for transactions signed during the current run
+type TransactionRecord struct {
+	TestCase        string     `json:"test_case"`
+	Category        string     `json:"category"`
+	Type            string     `json:"type,omitempty"`
+	TransactionHash string     `json:"transaction_hash"`
+	FromAddress     string     `json:"from_address"`
+	FromShardID     uint32     `json:"from_shard_id"`
+	ToAddress       string     `json:"to_address"`
+	ToShardID       uint32     `json:"to_shard_id"`
+	Amount          string     `json:"amount"`
+	Nonce           *uint64    `json:"nonce,omitempty"`
+	GasLimit        *int64     `json:"gas_limit,omitempty"`
+	GasPrice        string     `json:"gas_price,omitempty"`
+	GasUsed         *uint64    `json:"gas_used,omitempty"`
+	BlockNumber     *uint64    `json:"block_number,omitempty"`
+	Success         bool       `json:"success"`
+	Error           string     `json:"error,omitempty"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	ConfirmedAt     *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// TransactionRecords - flattens the transactions of the executed test cases to one record per transaction
+func TransactionRecords(results []*testing.TestCase) []TransactionRecord {
+	records := []TransactionRecord{}
+
+	for _, testCase := range results {
+		for _, tx := range testCase.Transactions {
+			records = append(records, transactionRecord(testCase, tx))
+		}
+	}
+
+	return records
+}
+
+// ExportTransactions - exports the transactions of the executed test cases as csv or json
+func ExportTransactions(results []*testing.TestCase) (string, error) {
+	format := strings.ToLower(config.Configuration.Export.Transactions)
+	if format == "" {
+		return "", nil
+	}
+
+	records := TransactionRecords(results)
+	fileName := fmt.Sprintf("%s-UTC-transactions.%s", utils.FormattedTimeString(config.Configuration.Framework.StartTime), format)
+	filePath := filepath.Join(config.Configuration.Export.Path, fileName)
+
+	switch format {
+	case "csv":
+		return filePath, writeTransactionsCSV(filePath, records)
+	case "json":
+		return filePath, writeTransactionsJSON(filePath, records)
+	default:
+		return "", fmt.Errorf("unknown transaction export format %s - valid formats: csv, json", format)
+	}
+}
+
+func transactionRecord(testCase *testing.TestCase, tx sdkTxs.Transaction) TransactionRecord {
+	record := TransactionRecord{
+		TestCase:        testCase.Name,
+		Category:        testCase.Category,
+		TransactionHash: tx.TransactionHash,
+		FromAddress:     tx.FromAddress,
+		FromShardID:     tx.FromShardID,
+		ToAddress:       tx.ToAddress,
+		ToShardID:       tx.ToShardID,
+		Success:         tx.Success,
+	}
+
+	if !tx.Amount.IsNil() {
+		record.Amount = tx.Amount.String()
+	}
+
+	if tx.Error != nil {
+		record.Error = tx.Error.Error()
+	}
+
+	if gasUsed, ok := receiptQuantity(tx.Response, "gasUsed"); ok {
+		record.GasUsed = &gasUsed
+	}
+
+	if blockNumber, ok := receiptQuantity(tx.Response, "blockNumber"); ok {
+		record.BlockNumber = &blockNumber
+	}
+
+	if trackedTx, ok := transactions.Tracked(tx.TransactionHash); ok && tx.TransactionHash != "" {
+		record.Type = trackedTx.Type
+		record.Nonce = &trackedTx.Nonce
+		record.GasLimit = &trackedTx.GasLimit
+		record.GasPrice = trackedTx.GasPrice
+
+		if record.Amount == "" {
+			record.Amount = trackedTx.Amount
+		}
+
+		if !trackedTx.SubmittedAt.IsZero() {
+			record.SubmittedAt = &trackedTx.SubmittedAt
+		}
+
+		if !trackedTx.ConfirmedAt.IsZero() {
+			record.ConfirmedAt = &trackedTx.ConfirmedAt
+		}
+	}
+
+	return record
+}
+
+// receiptQuantity - parses a quantity from a tx receipt, the rpc returns hex strings (v1) or numbers (v2)
+func receiptQuantity(receipt map[string]interface{}, key string) (uint64, bool) {
+	if receipt == nil {
+		return 0, false
+	}
+
+	switch value := receipt[key].(type) {
+	case float64:
+		return uint64(value), true
+	case string:
+		if strings.HasPrefix(value, "0x") {
+			quantity, ok := new(big.Int).SetString(value[2:], 16)
+			if !ok || !quantity.IsUint64() {
+				return 0, false
+			}
+			return quantity.Uint64(), true
+		}
+		quantity, err := strconv.ParseUint(value, 10, 64)
+		return quantity, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (record TransactionRecord) csvRow() []string {
+	return []string{
+		record.TestCase,
+		record.Category,
+		record.Type,
+		record.TransactionHash,
+		record.FromAddress,
+		fmt.Sprintf("%d", record.FromShardID),
+		record.ToAddress,
+		fmt.Sprintf("%d", record.ToShardID),
+		record.Amount,
+		optionalUint(record.Nonce),
+		optionalInt(record.GasLimit),
+		record.GasPrice,
+		optionalUint(record.GasUsed),
+		optionalUint(record.BlockNumber),
+		fmt.Sprintf("%t", record.Success),
+		record.Error,
+		optionalTime(record.SubmittedAt),
+		optionalTime(record.ConfirmedAt),
+	}
+}
+
+func writeTransactionsCSV(filePath string, records []TransactionRecord) error {
+	rows := [][]string{transactionHeaderRow}
+	for _, record := range records {
+		rows = append(rows, record.csvRow())
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+func writeTransactionsJSON(filePath string, records []TransactionRecord) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(records)
+}
+
+func optionalUint(value *uint64) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *value)
+}
+
+func optionalInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *value)
+}
+
+func optionalTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.Format("2006-01-02 15:04:05.000 UTC")
+}
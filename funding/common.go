@@ -29,8 +29,12 @@ func CalculateFundingDetails(amount numeric.Dec, multiple int64, shardID uint32)
 	return balance, requiredFunding, nil
 }
 
-// RetrieveFundingAccountBalance - retrieves the balance of the funding account in a specific shard
+// RetrieveFundingAccountBalance - retrieves the balance of the funding account (or the total balance of the funding pool) in a specific shard
 func RetrieveFundingAccountBalance(shardID uint32) (numeric.Dec, error) {
+	if FundingPool != nil {
+		return FundingPool.Balance(shardID)
+	}
+
 	fundingAccountBalance, err := balances.GetShardBalance(config.Configuration.Funding.Account.Address, shardID)
 	if err != nil {
 		err = errors.Wrapf(
@@ -0,0 +1,282 @@
+package funding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/go-sdk/pkg/address"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// The distributor contract pays a fixed amount to every address passed in the call data:
+//
+//	call data: <32 byte amount per recipient in atto> <32 byte left padded address> <32 byte left padded address> ...
+//
+// The whole call reverts if any of the transfers fails (e.g. if the attached value doesn't cover all transfers)
+//
+//	0x00 PUSH1 0x20                 ; offset = 32
+//	0x02 JUMPDEST                   ; loop
+//	0x03 CALLDATASIZE DUP2 LT ISZERO PUSH1 0x20 JUMPI
+//	0x0a PUSH1 0x00 DUP1 DUP1 DUP1  ; retSize, retOffset, argsSize, argsOffset
+//	0x0f PUSH1 0x00 CALLDATALOAD    ; amount
+//	0x12 DUP6 CALLDATALOAD          ; recipient
+//	0x14 GAS CALL ISZERO PUSH1 0x22 JUMPI
+//	0x1a PUSH1 0x20 ADD PUSH1 0x02 JUMP
+//	0x20 JUMPDEST STOP
+//	0x22 JUMPDEST PUSH1 0x00 DUP1 REVERT
+const (
+	distributorRuntimeCode = "60205b36811015602057600080808060003585355af1156022576020016002565b005b600080fd"
+	distributorInitCode    = "602780600b6000396000f3" + distributorRuntimeCode
+
+	// distributorDeploymentGas - intrinsic contract creation gas + call data + code deposit with a generous margin
+	distributorDeploymentGas = 200000
+)
+
+var (
+	distributorMutex sync.Mutex
+	distributors     = make(map[uint32]string)
+)
+
+// Distributor - returns the address of the distributor contract in a given shard - configured, previously deployed or newly deployed contracts are used in that order
+func Distributor(shardID uint32) (string, error) {
+	distributorMutex.Lock()
+	defer distributorMutex.Unlock()
+
+	if contractAddress, ok := distributors[shardID]; ok {
+		return contractAddress, nil
+	}
+
+	candidates := []string{}
+	if contractAddress, ok := config.Configuration.Funding.Batch.Contracts[shardID]; ok && contractAddress != "" {
+		candidates = append(candidates, contractAddress)
+	}
+
+	deployed, err := loadDistributors()
+	if err != nil {
+		return "", err
+	}
+	if contractAddress, ok := deployed[strconv.FormatUint(uint64(shardID), 10)]; ok && contractAddress != "" {
+		candidates = append(candidates, contractAddress)
+	}
+
+	for _, contractAddress := range candidates {
+		if isDistributor(contractAddress, shardID) {
+			distributors[shardID] = contractAddress
+			return contractAddress, nil
+		}
+		logger.WarningLog(fmt.Sprintf("The contract %s in shard %d isn't a distributor contract, ignoring it", contractAddress, shardID), config.Configuration.Funding.Verbose)
+	}
+
+	contractAddress, err := deployDistributor(shardID)
+	if err != nil {
+		return "", err
+	}
+
+	deployed[strconv.FormatUint(uint64(shardID), 10)] = contractAddress
+	if err := saveDistributors(deployed); err != nil {
+		logger.ErrorLog(fmt.Sprintf("Failed to persist the distributor contract address %s - error: %s", contractAddress, err.Error()), config.Configuration.Funding.Verbose)
+	}
+
+	distributors[shardID] = contractAddress
+
+	return contractAddress, nil
+}
+
+// BatchFundAccounts - funds a set of addresses in a given shard using the distributor contract, chunked according to the configured batch gas limit
+// Returns the addresses that were successfully funded - the remaining addresses should be funded using regular transfers
+func BatchFundAccounts(addresses []string, shardID uint32, amount numeric.Dec) (funded []string, err error) {
+	contractAddress, err := Distributor(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkSize := config.Configuration.Funding.Batch.ChunkSize()
+
+	for start := 0; start < len(addresses); start += chunkSize {
+		end := start + chunkSize
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+		chunk := addresses[start:end]
+
+		if err := distribute(contractAddress, chunk, shardID, amount); err != nil {
+			return funded, err
+		}
+
+		funded = append(funded, chunk...)
+	}
+
+	return funded, nil
+}
+
+func distribute(contractAddress string, addresses []string, shardID uint32, amount numeric.Dec) error {
+	data, err := distributionData(addresses, amount)
+	if err != nil {
+		return err
+	}
+
+	total := amount.Mul(numeric.NewDec(int64(len(addresses))))
+	gasLimit := uint64(21000+32*68) + uint64(len(addresses))*config.Configuration.Funding.Batch.RecipientGas
+
+	account := &config.Configuration.Funding.Account
+	if FundingPool != nil {
+		member, err := FundingPool.Acquire(shardID, total)
+		if err != nil {
+			return err
+		}
+		defer FundingPool.Release(member)
+		account = member.Account
+	}
+
+	logger.FundingLog(fmt.Sprintf("Batch funding %d accounts with %f each in shard %d using the distributor contract %s", len(addresses), amount, shardID, contractAddress), config.Configuration.Funding.Verbose)
+
+	signedTx, _, err := transactions.SignContractTransaction(account, shardID, contractAddress, total, -1, gasLimit, config.Configuration.Funding.Gas.Price, data)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := transactions.SendRawTransaction(signedTx, shardID, config.Configuration.Funding.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if !transactions.Succeeded(receipt) {
+		return fmt.Errorf("the distributor transaction %v failed or wasn't confirmed within %d seconds", receipt["transactionHash"], config.Configuration.Funding.Timeout)
+	}
+
+	return nil
+}
+
+func distributionData(addresses []string, amount numeric.Dec) ([]byte, error) {
+	atto := amount.Mul(sdkTxs.OneAsDec).TruncateInt()
+	if atto.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid batch funding amount %f", amount)
+	}
+
+	data := ethCommon.LeftPadBytes(atto.Bytes(), 32)
+	for _, recipient := range addresses {
+		data = append(data, ethCommon.LeftPadBytes(address.Parse(recipient).Bytes(), 32)...)
+	}
+
+	return data, nil
+}
+
+func deployDistributor(shardID uint32) (string, error) {
+	release, err := reserveFundingAccount()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	account := &config.Configuration.Funding.Account
+
+	logger.FundingLog(fmt.Sprintf("Deploying a new distributor contract in shard %d using the funding account %s", shardID, account.Address), config.Configuration.Funding.Verbose)
+
+	signedTx, nonce, err := transactions.SignContractTransaction(account, shardID, "", numeric.NewDec(0), -1, distributorDeploymentGas, config.Configuration.Funding.Gas.Price, ethCommon.FromHex(distributorInitCode))
+	if err != nil {
+		return "", err
+	}
+
+	receipt, err := transactions.SendRawTransaction(signedTx, shardID, config.Configuration.Funding.Timeout)
+	if err != nil {
+		return "", err
+	}
+
+	if !transactions.Succeeded(receipt) {
+		return "", fmt.Errorf("the distributor contract deployment %v failed or wasn't confirmed within %d seconds", receipt["transactionHash"], config.Configuration.Funding.Timeout)
+	}
+
+	contractAddress := address.ToBech32(crypto.CreateAddress(address.Parse(account.Address), nonce))
+	if !isDistributor(contractAddress, shardID) {
+		return "", fmt.Errorf("the distributor contract %s couldn't be found in shard %d after deploying it", contractAddress, shardID)
+	}
+
+	logger.FundingLog(fmt.Sprintf("Deployed the distributor contract %s in shard %d", contractAddress, shardID), config.Configuration.Funding.Verbose)
+
+	return contractAddress, nil
+}
+
+func isDistributor(contractAddress string, shardID uint32) bool {
+	code, err := transactions.GetCode(contractAddress, shardID)
+	if err != nil {
+		return false
+	}
+
+	return ethCommon.Bytes2Hex(code) == distributorRuntimeCode
+}
+
+// distributorsPath - the deployed distributor contracts are remembered next to the network profiles, files in keys/<network> are parsed as keystore files
+func distributorsPath() string {
+	return filepath.Join(config.Configuration.Framework.BasePath, "networks", fmt.Sprintf("%s.distributors.json", config.Configuration.Network.Name))
+}
+
+func loadDistributors() (map[string]string, error) {
+	deployed := make(map[string]string)
+
+	data, err := ioutil.ReadFile(distributorsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return deployed, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &deployed); err != nil {
+		return nil, fmt.Errorf("failed to parse the distributor contracts file %s - error: %s", distributorsPath(), err.Error())
+	}
+
+	return deployed, nil
+}
+
+func saveDistributors(deployed map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(distributorsPath()), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(deployed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(distributorsPath(), data, 0644)
+}
+
+// batchFundable - whether or not a set of accounts should be funded using the distributor contract
+func batchFundable(count int, fromShardID uint32, toShardID uint32) bool {
+	return config.Configuration.Funding.Batch.Enabled && fromShardID == toShardID && count >= config.Configuration.Funding.Batch.MinimumCount
+}
+
+// batchFundAccounts - funds the accounts using the distributor contract and falls back to regular transfers for the accounts that couldn't be batch funded
+func batchFundAccounts(accs []sdkAccounts.Account, shardID uint32, amount numeric.Dec) {
+	addresses := make([]string, len(accs))
+	for index, account := range accs {
+		addresses[index] = account.Address
+	}
+
+	funded, err := BatchFundAccounts(addresses, shardID, amount)
+	if err != nil {
+		logger.WarningLog(fmt.Sprintf("Batch funding failed after funding %d/%d accounts, falling back to regular transfers - error: %s", len(funded), len(addresses), err.Error()), true)
+	}
+
+	var waitGroup sync.WaitGroup
+	for _, recipient := range addresses[len(funded):] {
+		waitGroup.Add(1)
+		go func(recipient string) {
+			defer waitGroup.Done()
+			FundAccount(shardID, recipient, shardID, amount)
+		}(recipient)
+	}
+	waitGroup.Wait()
+}
@@ -143,6 +143,12 @@ func fundFundingAccountInNonBeaconShard(shardID uint32) (amount numeric.Dec, err
 		return numeric.NewDec(0), err
 	}
 
+	release, err := reserveFundingAccount()
+	if err != nil {
+		return numeric.NewDec(0), err
+	}
+	defer release()
+
 	err = PerformFundingTransaction(
 		&config.Configuration.Funding.Account,
 		0,
@@ -169,12 +175,15 @@ func GenerateAndFundAccounts(count int64, nameTemplate string, amount numeric.De
 		return nil, errors.Wrapf(err, "RPC Client")
 	}
 
+	// The funding pool members manage their own nonces, explicit nonces are only used when funding from the funding account
 	nonce := -1
-	receivedNonce := sdkNetworkNonce.CurrentNonce(rpcClient, config.Configuration.Funding.Account.Address)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Current Nonce")
+	if FundingPool == nil {
+		receivedNonce := sdkNetworkNonce.CurrentNonce(rpcClient, config.Configuration.Funding.Account.Address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Current Nonce")
+		}
+		nonce = int(receivedNonce)
 	}
-	nonce = int(receivedNonce)
 
 	_, err = balances.GetShardBalance(config.Configuration.Funding.Account.Address, fromShardID)
 	if err != nil {
@@ -186,13 +195,21 @@ func GenerateAndFundAccounts(count int64, nameTemplate string, amount numeric.De
 		return accs, errors.Wrapf(err, "Calculate Funding Amount")
 	}
 
+	if batchFundable(int(count), fromShardID, toShardID) {
+		accs = accounts.AsyncGenerateMultipleAccounts(nameTemplate, count)
+		batchFundAccounts(accs, fromShardID, amount)
+		return accs, nil
+	}
+
 	var waitGroup sync.WaitGroup
 	accountsChannel := make(chan sdkAccounts.Account, count)
 
 	for i := int64(0); i < count; i++ {
 		waitGroup.Add(1)
 		go generateAndFundAccount(i, nameTemplate, fromShardID, toShardID, amount, nonce, accountsChannel, &waitGroup)
-		nonce++
+		if nonce >= 0 {
+			nonce++
+		}
 	}
 
 	waitGroup.Wait()
@@ -212,6 +229,12 @@ func generateAndFundAccount(index int64, nameTemplate string, fromShardID uint32
 	account, err := accounts.GenerateAccount(accountName)
 
 	if err == nil {
+		if FundingPool != nil {
+			FundAccount(fromShardID, account.Address, toShardID, amount)
+			accountsChannel <- account
+			return
+		}
+
 		PerformFundingTransaction(
 			&config.Configuration.Funding.Account,
 			fromShardID,
@@ -285,14 +308,14 @@ func PerformFundingTransaction(account *sdkAccounts.Account, fromShardID uint32,
 				rawTx, err := transactions.SendTransaction(account, fromShardID, toAddress, toShardID, amount, nonce, gasLimit, gasPrice, "", config.Configuration.Funding.Timeout)
 
 				if err != nil {
-					if errors.Is(err, core.ErrUnderpriced) || errors.Is(err, core.ErrReplaceUnderpriced) || errors.Is(err, core.ErrIntrinsicGas) {
+					if transactions.IsError(err, core.ErrUnderpriced) || transactions.IsError(err, core.ErrReplaceUnderpriced) || transactions.IsError(err, core.ErrIntrinsicGas) {
 						gasPrice = sdkTransactions.BumpGasPrice(gasPrice)
 						logger.ErrorLog(fmt.Sprintf("Failed to perform funding transaction from %s (shard: %d) to %s (shard: %d) of amount %f - error: %s", account.Address, fromShardID, toAddress, toShardID, amount, err.Error()), config.Configuration.Funding.Verbose)
-					} else if errors.Is(err, core.ErrInsufficientFunds) {
+					} else if transactions.IsError(err, core.ErrInsufficientFunds) {
 						return err
 					} else if errors.Is(err, sdkErrors.ErrMissingAccount) {
 						fmt.Fprintf(os.Stderr, "error: %v\n", err)
-						os.Exit(1)
+						os.Exit(config.ExitFundingError)
 					}
 				} else {
 					success := sdkTransactions.IsTransactionSuccessful(rawTx)
@@ -0,0 +1,381 @@
+package funding
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/balances"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+var (
+	// FundingPool - the pool of funding accounts that funding requests are served from (only set when funding.pool.size > 1)
+	FundingPool *Pool
+)
+
+// Pool - a pool of funding accounts, every member can serve funding requests on its own which removes the single funding account nonce bottleneck
+// The shard balances of the members are rebalanced during the run using transfers from the member shards with the largest surplus
+type Pool struct {
+	Members []*PoolMember
+
+	mutex          sync.Mutex
+	rebalanceMutex sync.Mutex
+	next           int
+	stop           chan struct{}
+}
+
+// PoolMember - a funding account that is part of the funding pool
+type PoolMember struct {
+	Account *sdkAccounts.Account
+	busy    bool
+}
+
+// SetupFundingPool - sets up the funding pool using the funding account as the first member, performs the initial rebalancing and starts the periodic rebalancing
+func SetupFundingPool() error {
+	if !config.Configuration.Funding.Pool.Enabled() {
+		return nil
+	}
+
+	pool := &Pool{Members: []*PoolMember{{Account: &config.Configuration.Funding.Account}}}
+
+	for index := 1; index < config.Configuration.Funding.Pool.Size; index++ {
+		account, err := poolAccount(index)
+		if err != nil {
+			return err
+		}
+		account.Unlock()
+		accounts.Untrack(account.Address)
+		pool.Members = append(pool.Members, &PoolMember{Account: &account})
+	}
+
+	logger.FundingLog(fmt.Sprintf("Set up a funding pool of %d accounts - shard balances below %f will be topped up to %f every %d seconds", len(pool.Members), config.Configuration.Funding.Pool.Threshold, config.Configuration.Funding.Pool.Target, config.Configuration.Funding.Pool.Interval), true)
+
+	pool.Rebalance()
+	pool.Start(time.Duration(config.Configuration.Funding.Pool.Interval) * time.Second)
+
+	FundingPool = pool
+
+	return nil
+}
+
+// poolAccount - reuses an existing pool account (which might still hold funds from a previous run) or generates a new one
+func poolAccount(index int) (sdkAccounts.Account, error) {
+	name := fmt.Sprintf("%s_Pool_%d", config.Configuration.Funding.Account.Name, index)
+
+	if sdkAccounts.DoesNamedAccountExist(name) {
+		if address := sdkAccounts.FindAccountAddressByName(name); address != "" {
+			return sdkAccounts.Account{Name: name, Address: address, Passphrase: config.Configuration.Account.Passphrase}, nil
+		}
+	}
+
+	return accounts.GenerateAccount(name)
+}
+
+// FundAccount - funds a given address using the funding pool (if enabled) or the funding account
+func FundAccount(fromShardID uint32, toAddress string, toShardID uint32, amount numeric.Dec) error {
+	if FundingPool == nil {
+		return PerformFundingTransaction(
+			&config.Configuration.Funding.Account,
+			fromShardID,
+			toAddress,
+			toShardID,
+			amount,
+			-1,
+			config.Configuration.Funding.Gas.Limit,
+			config.Configuration.Funding.Gas.Price,
+			config.Configuration.Funding.Timeout,
+			config.Configuration.Funding.Retry.Attempts,
+		)
+	}
+
+	member, err := FundingPool.Acquire(fromShardID, amount)
+	if err != nil {
+		return err
+	}
+	defer FundingPool.Release(member)
+
+	return PerformFundingTransaction(
+		member.Account,
+		fromShardID,
+		toAddress,
+		toShardID,
+		amount,
+		-1,
+		config.Configuration.Funding.Gas.Limit,
+		config.Configuration.Funding.Gas.Price,
+		config.Configuration.Funding.Timeout,
+		config.Configuration.Funding.Retry.Attempts,
+	)
+}
+
+// Acquire - reserves a pool member that has the capacity to fund a given amount in a given shard
+// Waits for busy members to become available and rebalances the pool once if no member has the required capacity
+func (pool *Pool) Acquire(shardID uint32, amount numeric.Dec) (*PoolMember, error) {
+	required, err := CalculateFundingAmount(amount, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(time.Duration(config.Configuration.Funding.Timeout) * time.Second)
+	rebalanced := false
+
+	for {
+		checked := 0
+		for _, member := range pool.idleMembers() {
+			if !pool.reserve(member) {
+				continue
+			}
+			checked++
+
+			balance, err := balances.GetShardBalance(member.Account.Address, shardID)
+			if err == nil && !InsufficientBalance(balance, required) {
+				return member, nil
+			}
+
+			pool.Release(member)
+		}
+
+		if checked == len(pool.Members) {
+			if rebalanced {
+				return nil, fmt.Errorf("none of the %d funding pool accounts has the capacity to fund %f in shard %d", len(pool.Members), required, shardID)
+			}
+
+			pool.Rebalance()
+			rebalanced = true
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for a funding pool account with the capacity to fund %f in shard %d", required, shardID)
+		}
+
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+// Release - releases a previously acquired pool member
+func (pool *Pool) Release(member *PoolMember) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	member.busy = false
+}
+
+// Balance - the total balance of all pool members in a given shard
+func (pool *Pool) Balance(shardID uint32) (numeric.Dec, error) {
+	total := numeric.NewDec(0)
+
+	for _, member := range pool.Members {
+		balance, err := balances.GetShardBalance(member.Account.Address, shardID)
+		if err != nil {
+			return numeric.NewDec(0), err
+		}
+
+		if !balance.IsNil() {
+			total = total.Add(balance)
+		}
+	}
+
+	return total, nil
+}
+
+// Rebalance - tops up every member shard balance below the threshold to the target using transfers from the member shards with the largest surplus
+func (pool *Pool) Rebalance() {
+	pool.rebalanceMutex.Lock()
+	defer pool.rebalanceMutex.Unlock()
+
+	threshold := config.Configuration.Funding.Pool.Threshold
+	target := config.Configuration.Funding.Pool.Target
+	shards := uint32(config.Configuration.Network.Shards)
+
+	memberBalances := make(map[*PoolMember][]numeric.Dec)
+	for _, member := range pool.Members {
+		memberBalances[member] = make([]numeric.Dec, shards)
+		for shardID := uint32(0); shardID < shards; shardID++ {
+			balance, err := balances.GetShardBalance(member.Account.Address, shardID)
+			if err != nil || balance.IsNil() {
+				balance = numeric.NewDec(0)
+			}
+			memberBalances[member][shardID] = balance
+		}
+	}
+
+	for _, member := range pool.Members {
+		for shardID := uint32(0); shardID < shards; shardID++ {
+			balance := memberBalances[member][shardID]
+			if !balance.LT(threshold) {
+				continue
+			}
+
+			amount := target.Sub(balance)
+			required, err := CalculateFundingAmount(amount, 1)
+			if err != nil {
+				continue
+			}
+
+			source, sourceShardID, found := pool.largestSurplus(memberBalances, target, required, member, shardID)
+			if source == nil && found {
+				logger.FundingLog(fmt.Sprintf("Funding pool accounts with a surplus are busy - funding pool account %s in shard %d will be rebalanced during the next rebalancing", member.Account.Address, shardID), config.Configuration.Funding.Verbose)
+				continue
+			}
+
+			if source == nil {
+				logger.WarningLog(fmt.Sprintf("Funding pool account %s has a balance of %f in shard %d but no other pool account or shard has a surplus of %f to rebalance it with", member.Account.Address, balance, shardID, required), config.Configuration.Funding.Verbose)
+				continue
+			}
+
+			logger.FundingLog(fmt.Sprintf("Rebalancing funding pool - transferring %f from %s (shard: %d) to %s (shard: %d)", amount, source.Account.Address, sourceShardID, member.Account.Address, shardID), config.Configuration.Funding.Verbose)
+			err = PerformFundingTransaction(
+				source.Account,
+				sourceShardID,
+				member.Account.Address,
+				shardID,
+				amount,
+				-1,
+				config.Configuration.Funding.Gas.Limit,
+				config.Configuration.Funding.Gas.Price,
+				config.Configuration.Funding.Timeout,
+				config.Configuration.Funding.Retry.Attempts,
+			)
+			pool.Release(source)
+
+			if err != nil {
+				logger.ErrorLog(fmt.Sprintf("Failed to rebalance funding pool account %s in shard %d - error: %s", member.Account.Address, shardID, err.Error()), config.Configuration.Funding.Verbose)
+				continue
+			}
+
+			memberBalances[source][sourceShardID] = memberBalances[source][sourceShardID].Sub(required)
+			memberBalances[member][shardID] = target
+		}
+	}
+}
+
+// largestSurplus - finds and reserves the idle member shard with the largest surplus above the target that can cover a given amount
+// Busy candidates are skipped in favour of the next largest surplus, found reports whether any member shard has the required surplus at all
+func (pool *Pool) largestSurplus(memberBalances map[*PoolMember][]numeric.Dec, target numeric.Dec, required numeric.Dec, receiver *PoolMember, receiverShardID uint32) (source *PoolMember, sourceShardID uint32, found bool) {
+	type candidate struct {
+		member  *PoolMember
+		shardID uint32
+		surplus numeric.Dec
+	}
+	candidates := []candidate{}
+
+	for _, member := range pool.Members {
+		for shardID, balance := range memberBalances[member] {
+			if member == receiver && uint32(shardID) == receiverShardID {
+				continue
+			}
+
+			surplus := balance.Sub(target)
+			if surplus.LT(required) {
+				continue
+			}
+
+			candidates = append(candidates, candidate{member: member, shardID: uint32(shardID), surplus: surplus})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].surplus.GT(candidates[j].surplus)
+	})
+
+	for _, candidate := range candidates {
+		if pool.reserve(candidate.member) {
+			return candidate.member, candidate.shardID, true
+		}
+	}
+
+	return nil, 0, len(candidates) > 0
+}
+
+// Start - starts rebalancing the pool periodically
+func (pool *Pool) Start(interval time.Duration) {
+	pool.stop = make(chan struct{})
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				pool.Rebalance()
+			case <-pool.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop - stops the periodic rebalancing
+func (pool *Pool) Stop() {
+	if pool.stop != nil {
+		close(pool.stop)
+		pool.stop = nil
+	}
+}
+
+// reserveFundingAccount - reserves the pool member backed by the funding account so that the pool doesn't send from it at the same time as a direct funding account transaction
+// Every transaction sent directly from the funding account has to hold the reservation while the pool is enabled, the returned function releases it
+func reserveFundingAccount() (func(), error) {
+	pool := FundingPool
+	if pool == nil {
+		return func() {}, nil
+	}
+
+	var member *PoolMember
+	for _, candidate := range pool.Members {
+		if candidate.Account == &config.Configuration.Funding.Account {
+			member = candidate
+			break
+		}
+	}
+
+	if member == nil {
+		return func() {}, nil
+	}
+
+	deadline := time.Now().Add(time.Duration(config.Configuration.Funding.Timeout) * time.Second)
+	for !pool.reserve(member) {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for the funding pool to release the funding account %s", member.Account.Address)
+		}
+
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	return func() { pool.Release(member) }, nil
+}
+
+func (pool *Pool) idleMembers() (members []*PoolMember) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	count := len(pool.Members)
+	for offset := 0; offset < count; offset++ {
+		member := pool.Members[(pool.next+offset)%count]
+		if !member.busy {
+			members = append(members, member)
+		}
+	}
+	pool.next = (pool.next + 1) % count
+
+	return members
+}
+
+func (pool *Pool) reserve(member *PoolMember) bool {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if member.busy {
+		return false
+	}
+	member.busy = true
+
+	return true
+}
@@ -0,0 +1,68 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/utils"
+)
+
+// Record - persists the results of the current run, returns the id of the recorded run
+func Record(results []*testing.TestCase, dismissed []*testing.TestCase, duration time.Duration) (string, error) {
+	if !config.Configuration.History.Enabled {
+		return "", nil
+	}
+
+	run := Run{
+		ID:        fmt.Sprintf("%s-%s", utils.FormattedTimeString(config.Configuration.Framework.StartTime), config.Configuration.Framework.Run),
+		Network:   config.Configuration.Network.Name,
+		Version:   config.Configuration.Framework.Version,
+		Commit:    config.Commit,
+		StartedAt: config.Configuration.Framework.StartTime,
+		Duration:  duration,
+		Dismissed: len(dismissed),
+	}
+
+	records := []Result{}
+	for _, testCase := range results {
+		if testCase.Successful() {
+			run.Successful++
+		} else {
+			run.Failed++
+		}
+		records = append(records, run.result(testCase, true))
+	}
+
+	for _, testCase := range dismissed {
+		records = append(records, run.result(testCase, false))
+	}
+
+	store, err := Open(config.Configuration.History.Path)
+	if err != nil {
+		return "", err
+	}
+	defer store.Close()
+
+	if err := store.Save(run, records); err != nil {
+		return "", err
+	}
+
+	return run.ID, nil
+}
+
+func (run *Run) result(testCase *testing.TestCase, executed bool) Result {
+	return Result{
+		RunID:      run.ID,
+		Network:    run.Network,
+		Version:    run.Version,
+		Commit:     run.Commit,
+		TestCase:   testCase.Name,
+		Category:   testCase.Category,
+		Scenario:   testCase.Scenario,
+		Executed:   executed,
+		Successful: executed && testCase.Successful(),
+		Duration:   testCase.Duration(),
+	}
+}
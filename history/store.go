@@ -0,0 +1,145 @@
+// Package history persists the results of every test suite run to a local LevelDB database and compares runs over time
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Keys are structured as follows, run ids are timestamp based which keeps the runs of a network sorted chronologically:
+//
+//	run/<network>/<run id>                 -> Run
+//	result/<network>/<run id>/<test case>  -> Result
+
+// Run - a recorded test suite run
+type Run struct {
+	ID         string        `json:"id"`
+	Network    string        `json:"network"`
+	Version    string        `json:"version"`
+	Commit     string        `json:"commit"`
+	StartedAt  time.Time     `json:"started_at"`
+	Duration   time.Duration `json:"duration"`
+	Successful int           `json:"successful"`
+	Failed     int           `json:"failed"`
+	Dismissed  int           `json:"dismissed"`
+}
+
+// Result - the recorded result of a test case in a given run
+type Result struct {
+	RunID      string        `json:"run_id"`
+	Network    string        `json:"network"`
+	Version    string        `json:"version"`
+	Commit     string        `json:"commit"`
+	TestCase   string        `json:"test_case"`
+	Category   string        `json:"category"`
+	Scenario   string        `json:"scenario"`
+	Executed   bool          `json:"executed"`
+	Successful bool          `json:"successful"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Store - the run history database
+type Store struct {
+	db *leveldb.DB
+}
+
+// Open - opens (or creates) the run history database at a given path
+func Open(path string) (*Store, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the run history database %s - error: %s", path, err.Error())
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close - closes the database
+func (store *Store) Close() error {
+	return store.db.Close()
+}
+
+// Save - saves a run and the results of its test cases
+func (store *Store) Save(run Run, results []Result) error {
+	batch := new(leveldb.Batch)
+
+	data, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	batch.Put(runKey(run.Network, run.ID), data)
+
+	for _, result := range results {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		batch.Put(resultKey(run.Network, run.ID, result.TestCase), data)
+	}
+
+	return store.db.Write(batch, nil)
+}
+
+// Runs - the most recent runs of a given network, oldest first
+func (store *Store) Runs(network string, count int) (runs []Run, err error) {
+	iterator := store.db.NewIterator(util.BytesPrefix([]byte(fmt.Sprintf("run/%s/", network))), nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		run := Run{}
+		if err := json.Unmarshal(iterator.Value(), &run); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	if err := iterator.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].StartedAt.Before(runs[j].StartedAt) })
+
+	if count > 0 && len(runs) > count {
+		runs = runs[len(runs)-count:]
+	}
+
+	return runs, nil
+}
+
+// Results - the test case results of a given run
+func (store *Store) Results(network string, runID string) (results []Result, err error) {
+	iterator := store.db.NewIterator(util.BytesPrefix([]byte(fmt.Sprintf("result/%s/%s/", network, runID))), nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		result := Result{}
+		if err := json.Unmarshal(iterator.Value(), &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, iterator.Error()
+}
+
+// PassRate - the share of executed test cases that succeeded
+func (run *Run) PassRate() float64 {
+	executed := run.Successful + run.Failed
+	if executed == 0 {
+		return 0
+	}
+
+	return float64(run.Successful) / float64(executed)
+}
+
+func runKey(network string, runID string) []byte {
+	return []byte(fmt.Sprintf("run/%s/%s", network, runID))
+}
+
+func resultKey(network string, runID string, testCase string) []byte {
+	return []byte(fmt.Sprintf("result/%s/%s/%s", network, runID, testCase))
+}
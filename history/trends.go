@@ -0,0 +1,141 @@
+package history
+
+import (
+	"sort"
+	"time"
+)
+
+// Trend - the results of the test cases over a series of runs
+type Trend struct {
+	Runs      []Run
+	TestCases []string
+	// Results - the result of every test case per run (in the same order as the runs), nil if the test case wasn't part of a run
+	Results map[string][]*Result
+}
+
+// Regression - a test case whose latest duration exceeds the median duration of the previous runs
+type Regression struct {
+	TestCase string
+	Latest   time.Duration
+	Baseline time.Duration
+	Ratio    float64
+}
+
+// Flip - a test case that has flipped between passing and failing
+type Flip struct {
+	TestCase string
+	Outcomes []*Result
+	Flips    int
+}
+
+// LoadTrend - loads the results of the most recent runs of a given network
+func LoadTrend(store *Store, network string, count int) (*Trend, error) {
+	runs, err := store.Runs(network, count)
+	if err != nil {
+		return nil, err
+	}
+
+	trend := &Trend{Runs: runs, Results: make(map[string][]*Result)}
+
+	for index, run := range runs {
+		results, err := store.Results(network, run.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for resultIndex := range results {
+			result := &results[resultIndex]
+			if _, ok := trend.Results[result.TestCase]; !ok {
+				trend.Results[result.TestCase] = make([]*Result, len(runs))
+				trend.TestCases = append(trend.TestCases, result.TestCase)
+			}
+			trend.Results[result.TestCase][index] = result
+		}
+	}
+
+	sort.Strings(trend.TestCases)
+
+	return trend, nil
+}
+
+// Regressions - the test cases whose duration in the latest run exceeds the median duration of the previous runs by a given factor
+func (trend *Trend) Regressions(threshold float64) (regressions []Regression) {
+	if len(trend.Runs) < 2 {
+		return nil
+	}
+
+	latestIndex := len(trend.Runs) - 1
+
+	for _, testCase := range trend.TestCases {
+		results := trend.Results[testCase]
+
+		latest := results[latestIndex]
+		if latest == nil || !latest.Executed || latest.Duration <= 0 {
+			continue
+		}
+
+		durations := []time.Duration{}
+		for _, result := range results[:latestIndex] {
+			if result != nil && result.Executed && result.Duration > 0 {
+				durations = append(durations, result.Duration)
+			}
+		}
+
+		baseline := median(durations)
+		if baseline <= 0 {
+			continue
+		}
+
+		ratio := float64(latest.Duration) / float64(baseline)
+		if ratio >= threshold {
+			regressions = append(regressions, Regression{TestCase: testCase, Latest: latest.Duration, Baseline: baseline, Ratio: ratio})
+		}
+	}
+
+	sort.SliceStable(regressions, func(i, j int) bool { return regressions[i].Ratio > regressions[j].Ratio })
+
+	return regressions
+}
+
+// Flips - the test cases that have flipped between passing and failing across the runs, dismissed runs are ignored
+func (trend *Trend) Flips() (flips []Flip) {
+	for _, testCase := range trend.TestCases {
+		results := trend.Results[testCase]
+
+		count := 0
+		var previous *Result
+		for _, result := range results {
+			if result == nil || !result.Executed {
+				continue
+			}
+			if previous != nil && previous.Successful != result.Successful {
+				count++
+			}
+			previous = result
+		}
+
+		if count > 0 {
+			flips = append(flips, Flip{TestCase: testCase, Outcomes: results, Flips: count})
+		}
+	}
+
+	sort.SliceStable(flips, func(i, j int) bool { return flips[i].Flips > flips[j].Flips })
+
+	return flips
+}
+
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+
+	return sorted[middle]
+}
@@ -0,0 +1,274 @@
+package invariants
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	goSDKCommon "github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/balances"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+var (
+	reportsMutex sync.Mutex
+
+	// Reports - the invariant reports of every checked test case that leaked funds or state
+	Reports []Report
+)
+
+// Leak - funds or state a test case has left behind
+type Leak struct {
+	Kind    string
+	Address string
+	ShardID uint32
+	Amount  numeric.Dec
+	Detail  string
+}
+
+// Report - the outcome of the invariant checks of a test case
+type Report struct {
+	TestCase string
+	Leaks    []Leak
+	// Drain - how much the funding balance decreased while the test case was executed
+	Drain numeric.Dec
+	// Expected - the amounts sent to addresses outside of the run plus the estimated gas of every tx signed during the test case
+	Expected numeric.Dec
+	// Leaked - the total amount of the leaked funds
+	Leaked numeric.Dec
+	// Unexpected - the drain that isn't explained by the expected amounts, the leaked funds or the configured tolerance
+	Unexpected numeric.Dec
+}
+
+// Violated - whether or not the test case leaked funds or state or drained more than expected
+func (report *Report) Violated() bool {
+	return len(report.Leaks) > 0 || report.Unexpected.IsPositive()
+}
+
+// Check - snapshots the chain state after a test case has been executed and compares it to the snapshot taken before the test case
+func (snapshot *Snapshot) Check(testCase *testing.TestCase) {
+	if snapshot == nil {
+		return
+	}
+
+	after, err := Take()
+	if err != nil {
+		logger.WarningLog(fmt.Sprintf("Skipping the invariant checks for test case %s - failed to snapshot the chain state: %s", testCase.Name, err.Error()), true)
+		return
+	}
+
+	report := Compare(testCase.Name, snapshot, after)
+	if !report.Violated() {
+		return
+	}
+
+	report.Print()
+
+	reportsMutex.Lock()
+	Reports = append(Reports, report)
+	reportsMutex.Unlock()
+}
+
+// Compare - compares the chain state before and after a test case
+// Fixtures are meant to outlive the test cases creating them and are therefore not reported as leaks
+func Compare(testCase string, before *Snapshot, after *Snapshot) Report {
+	report := Report{
+		TestCase:   testCase,
+		Drain:      before.FundingBalance().Sub(after.FundingBalance()),
+		Expected:   numeric.NewDec(0),
+		Leaked:     numeric.NewDec(0),
+		Unexpected: numeric.NewDec(0),
+	}
+
+	for _, account := range sortedAccounts(after) {
+		if _, ok := before.Accounts[account]; ok || testing.Fixtures.Owns(account) {
+			continue
+		}
+		report.addLeaks(accountLeaks(account))
+	}
+
+	for address := range after.Validators {
+		if before.Validators[address] || testing.Fixtures.Owns(address) {
+			continue
+		}
+		report.addLeaks(validatorLeaks(address))
+	}
+
+	report.Expected = expectedDrain(before.Transactions, after)
+
+	unexpected := report.Drain.Sub(report.Expected).Sub(report.Leaked).Sub(config.Configuration.Invariants.Tolerance)
+	if unexpected.IsPositive() {
+		report.Unexpected = unexpected
+	}
+
+	return report
+}
+
+// Print - outputs the report of a test case
+func (report *Report) Print() {
+	logger.WarningLog(fmt.Sprintf("Invariant checks for test case %s - funding drain: %f, expected: %f, leaked: %f, unexpected: %f", report.TestCase, report.Drain, report.Expected, report.Leaked, report.Unexpected), true)
+
+	for _, leak := range report.Leaks {
+		logger.WarningLog(fmt.Sprintf("\t%s", leak.String()), true)
+	}
+}
+
+// String - a description of the leak
+func (leak Leak) String() string {
+	message := fmt.Sprintf("%s leaked by %s in shard %d", leak.Kind, leak.Address, leak.ShardID)
+
+	if !leak.Amount.IsNil() {
+		message = fmt.Sprintf("%s: %f", message, leak.Amount)
+	}
+
+	if leak.Detail != "" {
+		message = fmt.Sprintf("%s (%s)", message, leak.Detail)
+	}
+
+	return message
+}
+
+// PrintSummary - outputs the test cases that have violated the invariants
+func PrintSummary() {
+	if !config.Configuration.Invariants.Enabled {
+		return
+	}
+
+	reportsMutex.Lock()
+	defer reportsMutex.Unlock()
+
+	fmt.Println("")
+	fmt.Println("Invariant checks:")
+	fmt.Println(strings.Repeat("-", 50))
+	if len(Reports) == 0 {
+		fmt.Println("No test case has leaked funds or state")
+	}
+	for _, report := range Reports {
+		fmt.Printf("Testcase %s: %d leak(s), leaked: %f, unexpected drain: %f\n", report.TestCase, len(report.Leaks), report.Leaked, report.Unexpected)
+	}
+	fmt.Println(strings.Repeat("-", 50))
+}
+
+func (report *Report) addLeaks(leaks []Leak) {
+	for _, leak := range leaks {
+		report.Leaks = append(report.Leaks, leak)
+		if !leak.Amount.IsNil() && leak.Amount.IsPositive() {
+			report.Leaked = report.Leaked.Add(leak.Amount)
+		}
+	}
+}
+
+// accountLeaks - the funds a generated account still holds in any shard (beyond what can't be swept due to the gas cost) and its remaining delegations
+func accountLeaks(address string) (leaks []Leak) {
+	gasCost := config.Configuration.Funding.Gas.Cost
+
+	for shardID := uint32(0); shardID < uint32(config.Configuration.Network.Shards); shardID++ {
+		balance, err := balances.GetShardBalance(address, shardID)
+		if err != nil || balance.IsNil() {
+			leaks = append(leaks, Leak{Kind: "balance", Address: address, ShardID: shardID, Detail: "failed to retrieve the balance"})
+			continue
+		}
+
+		if balance.GT(gasCost) {
+			leaks = append(leaks, Leak{Kind: "balance", Address: address, ShardID: shardID, Amount: balance})
+		}
+	}
+
+	delegations, err := staking.DelegationsByDelegator(address)
+	if err != nil {
+		return append(leaks, Leak{Kind: "delegation", Address: address, Detail: fmt.Sprintf("failed to retrieve the delegations: %s", err.Error())})
+	}
+
+	for _, delegation := range delegations {
+		if !delegation.Amount.IsNil() && delegation.Amount.IsPositive() {
+			leaks = append(leaks, Leak{Kind: "delegation", Address: address, Amount: delegation.Amount, Detail: fmt.Sprintf("delegated to %s", delegation.ValidatorAddress)})
+		}
+
+		if !delegation.Reward.IsNil() && delegation.Reward.IsPositive() {
+			leaks = append(leaks, Leak{Kind: "reward", Address: address, Amount: delegation.Reward, Detail: fmt.Sprintf("uncollected rewards from %s", delegation.ValidatorAddress)})
+		}
+
+		for _, undelegation := range delegation.Undelegations {
+			if !undelegation.Amount.IsNil() && undelegation.Amount.IsPositive() {
+				leaks = append(leaks, Leak{Kind: "undelegation", Address: address, Amount: undelegation.Amount, Detail: fmt.Sprintf("undelegated from %s in epoch %d, still locked", delegation.ValidatorAddress, undelegation.Epoch)})
+			}
+		}
+	}
+
+	return leaks
+}
+
+// validatorLeaks - a validator created by the test case that's still active
+func validatorLeaks(address string) []Leak {
+	validatorInfo, err := staking.ValidatorInformation(address, 0)
+	if err != nil {
+		return []Leak{{Kind: "validator", Address: address, Detail: fmt.Sprintf("failed to retrieve the validator information: %s", err.Error())}}
+	}
+
+	if strings.EqualFold(validatorInfo.Validator.EligibilityStatus, "active") {
+		return []Leak{{Kind: "validator", Address: address, Detail: fmt.Sprintf("left active - %s", validatorInfo.EposStatus)}}
+	}
+
+	return nil
+}
+
+// expectedDrain - the amounts of the txs signed since a given tx count sent to addresses outside of the run plus the estimated gas cost of every tx
+func expectedDrain(sinceCount int, after *Snapshot) numeric.Dec {
+	gasCost := config.Configuration.Network.Gas.Cost
+	if gasCost.IsNil() || (!config.Configuration.Funding.Gas.Cost.IsNil() && config.Configuration.Funding.Gas.Cost.GT(gasCost)) {
+		gasCost = config.Configuration.Funding.Gas.Cost
+	}
+
+	internal := internalAddresses(after)
+	expected := numeric.NewDec(0)
+
+	for _, tx := range transactions.TrackedSince(sinceCount) {
+		if !gasCost.IsNil() {
+			expected = expected.Add(gasCost)
+		}
+
+		if tx.Receiver == "" || tx.Amount == "" || internal[tx.Receiver] {
+			continue
+		}
+
+		if amount, err := goSDKCommon.NewDecFromString(tx.Amount); err == nil {
+			expected = expected.Add(amount)
+		}
+	}
+
+	return expected
+}
+
+// internalAddresses - the funding account(s) and the generated accounts, transfers between them don't drain the funding balance
+func internalAddresses(snapshot *Snapshot) map[string]bool {
+	internal := map[string]bool{config.Configuration.Funding.Account.Address: true}
+
+	if funding.FundingPool != nil {
+		for _, member := range funding.FundingPool.Members {
+			internal[member.Account.Address] = true
+		}
+	}
+
+	for address := range snapshot.Accounts {
+		// funds sent to fixtures are expected to stay there until the end of the suite
+		internal[address] = !testing.Fixtures.Owns(address)
+	}
+
+	return internal
+}
+
+func sortedAccounts(snapshot *Snapshot) (addresses []string) {
+	for address := range snapshot.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
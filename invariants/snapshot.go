@@ -0,0 +1,83 @@
+package invariants
+
+import (
+	"fmt"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// Snapshot - the chain state relevant to the invariant checks at a given point of the run
+type Snapshot struct {
+	// Funding - the balance of the funding account (or the total balance of the funding pool) per shard
+	Funding map[uint32]numeric.Dec
+	// Accounts - the generated accounts that are still tracked for the final teardown
+	Accounts map[string]sdkAccounts.Account
+	// Validators - the validators created by the run
+	Validators map[string]bool
+	// Transactions - the number of transactions signed by the run
+	Transactions int
+}
+
+// Start - takes the snapshot before a test case gets executed, returns nil if the invariant checker isn't enabled or if the snapshot couldn't be taken
+func Start(testCase *testing.TestCase) *Snapshot {
+	if !config.Configuration.Invariants.Enabled {
+		return nil
+	}
+
+	snapshot, err := Take()
+	if err != nil {
+		logger.WarningLog(fmt.Sprintf("Skipping the invariant checks for test case %s - failed to snapshot the chain state: %s", testCase.Name, err.Error()), true)
+		return nil
+	}
+
+	return snapshot
+}
+
+// Take - snapshots the current chain state
+func Take() (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Funding:      make(map[uint32]numeric.Dec),
+		Accounts:     make(map[string]sdkAccounts.Account),
+		Validators:   make(map[string]bool),
+		Transactions: transactions.TrackedCount(),
+	}
+
+	for shardID := uint32(0); shardID < uint32(config.Configuration.Network.Shards); shardID++ {
+		balance, err := funding.RetrieveFundingAccountBalance(shardID)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Funding[shardID] = balance
+	}
+
+	for _, account := range accounts.Tracked() {
+		snapshot.Accounts[account.Address] = account
+	}
+
+	for _, address := range staking.CreatedValidators() {
+		snapshot.Validators[address] = true
+	}
+
+	return snapshot, nil
+}
+
+// FundingBalance - the total funding balance across all shards
+func (snapshot *Snapshot) FundingBalance() numeric.Dec {
+	total := numeric.NewDec(0)
+
+	for _, balance := range snapshot.Funding {
+		if !balance.IsNil() {
+			total = total.Add(balance)
+		}
+	}
+
+	return total
+}
@@ -2,9 +2,11 @@ package keys
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	sdkAccounts "github.com/harmony-one/go-lib/accounts"
@@ -39,12 +41,11 @@ func LoadKeys() (allAccounts []sdkAccounts.Account, err error) {
 	return allAccounts, nil
 }
 
-// LoadPrivateKeys - loads the source accounts using a .txt file including new line separated private keys
+// LoadPrivateKeys - loads the source accounts using the private keys from the configured key sources (by default a .txt file including new line separated private keys)
 func LoadPrivateKeys() (accs []sdkAccounts.Account, err error) {
 	unfilteredAccounts := []sdkAccounts.Account{}
 
-	path := filepath.Join(config.Configuration.Framework.BasePath, "keys", config.Configuration.Network.Name, "private_keys.txt")
-	privateKeys, err := utils.FileToLines(path)
+	privateKeys, err := LoadSourcePrivateKeys()
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +105,7 @@ func LoadKeystoreKeys() (accs []sdkAccounts.Account, err error) {
 	return accs, nil
 }
 
-// FilterKeys - filters keys based on available balance and potentially removes keys without any balances (if enabled in the configuration)
+// FilterKeys - filters keys based on available balance and potentially quarantines keys without any balances (if enabled in the configuration)
 func FilterKeys(unfilteredAccounts []sdkAccounts.Account) (accounts []sdkAccounts.Account, err error) {
 	hasFunds, missingFunds, err := balances.FilterMinimumBalanceAccounts(unfilteredAccounts, config.Configuration.Funding.MinimumFunds)
 	if err != nil {
@@ -119,7 +120,12 @@ func FilterKeys(unfilteredAccounts []sdkAccounts.Account) (accounts []sdkAccount
 
 			if len(KeyMapping) > 0 {
 				if sourcePath, ok := KeyMapping[account.Address]; ok {
-					os.RemoveAll(sourcePath)
+					reason := fmt.Sprintf("total balance didn't exceed the minimum funds of %f", config.Configuration.Funding.MinimumFunds)
+					if err := QuarantineKey(account.Address, sourcePath, reason); err != nil {
+						fmt.Println(fmt.Sprintf("Failed to quarantine the keystore file %s for address %s - error: %s", sourcePath, account.Address, err.Error()))
+					} else {
+						delete(KeyMapping, account.Address)
+					}
 				}
 			}
 		}
@@ -133,9 +139,13 @@ func FilterKeys(unfilteredAccounts []sdkAccounts.Account) (accounts []sdkAccount
 }
 
 // IdentifyKeystoreKeys - identifies the key store files in a given path - also supports an unlimited amount of subdirectories
+// Keys that have been moved to the quarantine directory are ignored
 func IdentifyKeystoreKeys(path string) error {
 	var files []string
 	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if info != nil && info.IsDir() && path == QuarantinePath() {
+			return filepath.SkipDir
+		}
 		files = append(files, path)
 		return nil
 	})
@@ -145,7 +155,7 @@ func IdentifyKeystoreKeys(path string) error {
 	}
 
 	for _, file := range files {
-		if filepath.Ext(file) != ".txt" {
+		if filepath.Ext(file) != ".txt" && filepath.Ext(file) != ".bundle" {
 			filePath, err := filepath.Abs(file)
 
 			if err != nil {
@@ -179,8 +189,17 @@ func parseKeystoreJSON(data string) (map[string]interface{}, error) {
 		return nil, err
 	}
 
-	jsonData := rawData.(map[string]interface{})
-	ethAddress := jsonData["address"].(string)
+	// Skip JSON files that aren't keystore files (e.g. encrypted key bundles)
+	jsonData, ok := rawData.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("not a keystore file")
+	}
+
+	ethAddress, ok := jsonData["address"].(string)
+	if !ok || ethAddress == "" {
+		return nil, errors.New("not a keystore file - missing address")
+	}
+
 	bech32Address := address.ToBech32(address.Parse(ethAddress))
 
 	if bech32Address != "" {
@@ -203,3 +222,47 @@ func PrivateKeyToAddress(privateKey string) (string, error) {
 
 	return formattedAddress, nil
 }
+
+// KeyDetails - represents an identified source key and where it's stored
+type KeyDetails struct {
+	Address     string
+	Path        string
+	Quarantined bool
+}
+
+// ListKeys - lists all source keys for the current network (private keys from the key sources, keystore files and quarantined keystore files) without importing them
+func ListKeys() (keys []KeyDetails, err error) {
+	path := filepath.Join(config.Configuration.Framework.BasePath, "keys", config.Configuration.Network.Name)
+
+	privateKeys, err := LoadSourcePrivateKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeySources := fmt.Sprintf("key sources: %s", strings.Join(config.Configuration.Account.Keys.Sources, ", "))
+	for _, privateKey := range privateKeys {
+		if address, err := PrivateKeyToAddress(privateKey); err == nil {
+			keys = append(keys, KeyDetails{Address: address, Path: privateKeySources})
+		}
+	}
+
+	KeyMapping = make(map[string]string)
+	if err := IdentifyKeystoreKeys(path); err != nil {
+		return nil, err
+	}
+
+	for address, keyPath := range KeyMapping {
+		keys = append(keys, KeyDetails{Address: address, Path: keyPath})
+	}
+
+	manifest, err := LoadQuarantineManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range manifest.Entries {
+		keys = append(keys, KeyDetails{Address: entry.Address, Path: filepath.Join(QuarantinePath(), entry.FileName), Quarantined: true})
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,175 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+// QuarantineEntry - represents a keystore file that has been moved to the quarantine directory
+type QuarantineEntry struct {
+	Address       string    `json:"address"`
+	FileName      string    `json:"file_name"`
+	SourcePath    string    `json:"source_path"`
+	Reason        string    `json:"reason"`
+	QuarantinedAt time.Time `json:"quarantined_at"`
+}
+
+// QuarantineManifest - represents the manifest of all keystore files that have been quarantined for a given network
+type QuarantineManifest struct {
+	Entries []QuarantineEntry `json:"entries"`
+}
+
+// QuarantinePath - the path to the quarantine directory for the current network
+func QuarantinePath() string {
+	return filepath.Join(config.Configuration.Framework.BasePath, "keys", config.Configuration.Network.Name, "quarantine")
+}
+
+func quarantineManifestPath() string {
+	return filepath.Join(QuarantinePath(), "manifest.json")
+}
+
+// LoadQuarantineManifest - loads the quarantine manifest for the current network - a missing manifest results in an empty manifest
+func LoadQuarantineManifest() (manifest QuarantineManifest, err error) {
+	data, err := ioutil.ReadFile(quarantineManifestPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return manifest, nil
+		}
+		return manifest, err
+	}
+
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return manifest, fmt.Errorf("failed to parse the quarantine manifest %s - error: %s", quarantineManifestPath(), err.Error())
+	}
+
+	return manifest, nil
+}
+
+// Save - persists the quarantine manifest
+func (manifest *QuarantineManifest) Save() error {
+	if err := os.MkdirAll(QuarantinePath(), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(quarantineManifestPath(), data, 0644)
+}
+
+// Find - finds the quarantine entry for a given address
+func (manifest *QuarantineManifest) Find(address string) (QuarantineEntry, bool) {
+	for _, entry := range manifest.Entries {
+		if strings.EqualFold(entry.Address, address) {
+			return entry, true
+		}
+	}
+
+	return QuarantineEntry{}, false
+}
+
+// QuarantineKey - moves a keystore file to the quarantine directory instead of deleting it and registers it in the quarantine manifest
+func QuarantineKey(address string, sourcePath string, reason string) error {
+	manifest, err := LoadQuarantineManifest()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(QuarantinePath(), 0755); err != nil {
+		return err
+	}
+
+	fileName := filepath.Base(sourcePath)
+	if _, err := os.Stat(filepath.Join(QuarantinePath(), fileName)); err == nil {
+		fileName = fmt.Sprintf("%s-%s", address, fileName)
+	}
+
+	if err := os.Rename(sourcePath, filepath.Join(QuarantinePath(), fileName)); err != nil {
+		return err
+	}
+
+	manifest.Entries = append(manifest.Entries, QuarantineEntry{
+		Address:       address,
+		FileName:      fileName,
+		SourcePath:    sourcePath,
+		Reason:        reason,
+		QuarantinedAt: time.Now().UTC(),
+	})
+
+	return manifest.Save()
+}
+
+// RestoreKeys - moves quarantined keystore files back to their original location - restores all quarantined keys if no addresses are supplied
+func RestoreKeys(addresses []string) (restored []QuarantineEntry, err error) {
+	manifest, err := LoadQuarantineManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range addresses {
+		if _, ok := manifest.Find(address); !ok {
+			return nil, fmt.Errorf("couldn't find any quarantined key for the address %s", address)
+		}
+	}
+
+	remaining := []QuarantineEntry{}
+	for _, entry := range manifest.Entries {
+		if len(addresses) > 0 && !containsAddress(addresses, entry.Address) {
+			remaining = append(remaining, entry)
+			continue
+		}
+
+		if restoreErr := restoreKey(entry); restoreErr != nil {
+			if err == nil {
+				err = restoreErr
+			}
+			remaining = append(remaining, entry)
+			continue
+		}
+
+		restored = append(restored, entry)
+	}
+
+	manifest.Entries = remaining
+	if saveErr := manifest.Save(); saveErr != nil {
+		return restored, saveErr
+	}
+
+	return restored, err
+}
+
+func restoreKey(entry QuarantineEntry) error {
+	if _, err := os.Stat(entry.SourcePath); err == nil {
+		return fmt.Errorf("can't restore the key for the address %s - a file already exists at %s", entry.Address, entry.SourcePath)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(entry.SourcePath), 0755); err != nil {
+		return err
+	}
+
+	quarantinedPath := filepath.Join(QuarantinePath(), entry.FileName)
+	if _, err := os.Stat(quarantinedPath); err != nil {
+		return fmt.Errorf("the quarantined key file %s for the address %s is missing", quarantinedPath, entry.Address)
+	}
+
+	return os.Rename(quarantinedPath, entry.SourcePath)
+}
+
+func containsAddress(addresses []string, address string) bool {
+	for _, addr := range addresses {
+		if strings.EqualFold(addr, address) {
+			return true
+		}
+	}
+
+	return false
+}
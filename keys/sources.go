@@ -0,0 +1,271 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/utils"
+)
+
+// KeySource - represents a source that private keys can be loaded from
+type KeySource interface {
+	Name() string
+	PrivateKeys() ([]string, error)
+}
+
+// KeySourceGenerator - generates a key source using the current configuration
+type KeySourceGenerator func() KeySource
+
+var (
+	// KeySourceGenerators - all available key sources - additional sources can be registered using RegisterKeySource
+	KeySourceGenerators = map[string]KeySourceGenerator{
+		"file":   func() KeySource { return &FileKeySource{Path: PrivateKeysPath()} },
+		"bundle": func() KeySource { return &BundleKeySource{Path: config.Configuration.Account.Keys.Bundle} },
+		"env":    func() KeySource { return &EnvKeySource{Prefix: config.Configuration.Account.Keys.Env} },
+		"vault":  func() KeySource { return &VaultKeySource{Settings: config.Configuration.Account.Keys.Vault} },
+	}
+)
+
+// RegisterKeySource - registers a key source that can be enabled using account.keys.sources or --key-sources
+func RegisterKeySource(name string, generator KeySourceGenerator) {
+	KeySourceGenerators[strings.ToLower(name)] = generator
+}
+
+// PrivateKeysPath - the path to the plaintext private keys file for the current network
+func PrivateKeysPath() string {
+	return filepath.Join(config.Configuration.Framework.BasePath, "keys", config.Configuration.Network.Name, "private_keys.txt")
+}
+
+// LoadSourcePrivateKeys - loads all unique private keys from all of the configured key sources
+func LoadSourcePrivateKeys() (privateKeys []string, err error) {
+	identified := make(map[string]bool)
+
+	for _, name := range config.Configuration.Account.Keys.Sources {
+		generator, ok := KeySourceGenerators[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown key source %s - available key sources are: %s", name, strings.Join(keySourceNames(), ", "))
+		}
+
+		source := generator()
+		sourceKeys, err := source.PrivateKeys()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load private keys from the %s key source - error: %s", source.Name(), err.Error())
+		}
+
+		for _, privateKey := range sourceKeys {
+			privateKey = normalizePrivateKey(privateKey)
+			if privateKey != "" && !identified[privateKey] {
+				identified[privateKey] = true
+				privateKeys = append(privateKeys, privateKey)
+			}
+		}
+	}
+
+	return privateKeys, nil
+}
+
+// FileKeySource - loads new line separated plaintext private keys from a file
+type FileKeySource struct {
+	Path string
+}
+
+// Name - the name of the key source
+func (source *FileKeySource) Name() string {
+	return "file"
+}
+
+// PrivateKeys - loads the private keys from the file
+func (source *FileKeySource) PrivateKeys() ([]string, error) {
+	return utils.FileToLines(source.Path)
+}
+
+// BundleKeySource - loads private keys from a scrypt + AES-128-CTR encrypted key bundle, decrypted using the account passphrase
+type BundleKeySource struct {
+	Path string
+}
+
+// Name - the name of the key source
+func (source *BundleKeySource) Name() string {
+	return "bundle"
+}
+
+// PrivateKeys - decrypts the key bundle and returns the private keys it contains
+func (source *BundleKeySource) PrivateKeys() ([]string, error) {
+	data, err := ioutil.ReadFile(source.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecryptKeyBundle(data, config.Configuration.Account.Passphrase)
+}
+
+// EncryptKeyBundle - encrypts a set of private keys using a given passphrase, the bundle uses the same format as the crypto section of a keystore file
+func EncryptKeyBundle(privateKeys []string, passphrase string) ([]byte, error) {
+	cryptoJSON, err := keystore.EncryptDataV3([]byte(strings.Join(privateKeys, "\n")), []byte(passphrase), keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(cryptoJSON, "", "  ")
+}
+
+// DecryptKeyBundle - decrypts a key bundle using a given passphrase
+func DecryptKeyBundle(data []byte, passphrase string) ([]string, error) {
+	var cryptoJSON keystore.CryptoJSON
+	if err := json.Unmarshal(data, &cryptoJSON); err != nil {
+		return nil, err
+	}
+
+	decrypted, err := keystore.DecryptDataV3(cryptoJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(string(decrypted), "\n"), nil
+}
+
+// EnvKeySource - loads private keys from environment variables named <prefix> or <prefix>_<suffix>, each variable can hold comma or new line separated keys
+type EnvKeySource struct {
+	Prefix string
+}
+
+// Name - the name of the key source
+func (source *EnvKeySource) Name() string {
+	return "env"
+}
+
+// PrivateKeys - loads the private keys from the matching environment variables
+func (source *EnvKeySource) PrivateKeys() (privateKeys []string, err error) {
+	if source.Prefix == "" {
+		return nil, fmt.Errorf("no environment variable prefix has been configured - set account.keys.env")
+	}
+
+	names := []string{}
+	values := make(map[string]string)
+
+	for _, variable := range os.Environ() {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) == 2 && (parts[0] == source.Prefix || strings.HasPrefix(parts[0], source.Prefix+"_")) {
+			names = append(names, parts[0])
+			values[parts[0]] = parts[1]
+		}
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		privateKeys = append(privateKeys, splitPrivateKeys(values[name])...)
+	}
+
+	return privateKeys, nil
+}
+
+// VaultKeySource - loads private keys from a HashiCorp Vault compatible HTTP KV store (KV version 1 or 2)
+type VaultKeySource struct {
+	Settings config.Vault
+}
+
+// Name - the name of the key source
+func (source *VaultKeySource) Name() string {
+	return "vault"
+}
+
+// PrivateKeys - fetches the secret from the KV store and returns the private keys stored in the configured field
+func (source *VaultKeySource) PrivateKeys() ([]string, error) {
+	if source.Settings.Address == "" {
+		return nil, fmt.Errorf("no vault address has been configured - set account.keys.vault.address or VAULT_ADDR")
+	}
+
+	request, err := http.NewRequest("GET", source.secretURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.Settings.Token != "" {
+		request.Header.Set("X-Vault-Token", source.Settings.Token)
+	}
+
+	client := &http.Client{Timeout: time.Duration(source.Settings.Timeout) * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with status %s", source.secretURL(), response.Status)
+	}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&secret); err != nil {
+		return nil, err
+	}
+
+	data := secret.Data
+	if source.Settings.Version == 2 {
+		nested, ok := data["data"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s didn't return a KV version 2 secret", source.secretURL())
+		}
+		data = nested
+	}
+
+	switch value := data[source.Settings.Field].(type) {
+	case string:
+		return splitPrivateKeys(value), nil
+	case []interface{}:
+		privateKeys := []string{}
+		for _, item := range value {
+			if privateKey, ok := item.(string); ok {
+				privateKeys = append(privateKeys, privateKey)
+			}
+		}
+		return privateKeys, nil
+	default:
+		return nil, fmt.Errorf("the secret at %s doesn't contain the field %s", source.secretURL(), source.Settings.Field)
+	}
+}
+
+func (source *VaultKeySource) secretURL() string {
+	address := strings.TrimRight(source.Settings.Address, "/")
+	mount := strings.Trim(source.Settings.Mount, "/")
+	path := strings.Trim(source.Settings.Path, "/")
+
+	if source.Settings.Version == 2 {
+		return fmt.Sprintf("%s/v1/%s/data/%s", address, mount, path)
+	}
+
+	return fmt.Sprintf("%s/v1/%s/%s", address, mount, path)
+}
+
+func splitPrivateKeys(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ' ' || r == '\t'
+	})
+}
+
+func normalizePrivateKey(privateKey string) string {
+	privateKey = strings.TrimSpace(privateKey)
+	privateKey = strings.TrimPrefix(privateKey, "0x")
+
+	return privateKey
+}
+
+func keySourceNames() (names []string) {
+	for name := range KeySourceGenerators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
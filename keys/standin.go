@@ -0,0 +1,55 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+// VaultStandIn - a stand-in Vault KV store serving a single secret holding a set of private keys
+// It's meant for verifying the vault key source locally and shouldn't be exposed on a public interface
+type VaultStandIn struct {
+	Settings    config.Vault
+	PrivateKeys []string
+}
+
+// ServeHTTP - serves the secret using the KV version 1 or 2 read format depending on the configured version
+func (standIn *VaultStandIn) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, fmt.Sprintf("method %s isn't supported", request.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if standIn.Settings.Token != "" && request.Header.Get("X-Vault-Token") != standIn.Settings.Token {
+		http.Error(writer, "permission denied", http.StatusForbidden)
+		return
+	}
+
+	if request.URL.Path != standIn.SecretPath() {
+		http.NotFound(writer, request)
+		return
+	}
+
+	data := map[string]interface{}{standIn.Settings.Field: strings.Join(standIn.PrivateKeys, ",")}
+	if standIn.Settings.Version == 2 {
+		data = map[string]interface{}{"data": data}
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(map[string]interface{}{"data": data})
+}
+
+// SecretPath - the request path the secret is served at, matching the path requested by the vault key source
+func (standIn *VaultStandIn) SecretPath() string {
+	mount := strings.Trim(standIn.Settings.Mount, "/")
+	path := strings.Trim(standIn.Settings.Path, "/")
+
+	if standIn.Settings.Version == 2 {
+		return fmt.Sprintf("/v1/%s/data/%s", mount, path)
+	}
+
+	return fmt.Sprintf("/v1/%s/%s", mount, path)
+}
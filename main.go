@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	_ "net/http/pprof"
+	"path/filepath"
+
+	_ "github.com/harmony-one/harmony-tf/commands"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testcases"
+)
+
+func main() {
+	if !config.Execute() {
+		return
+	}
+
+	basePath, err := filepath.Abs(config.Args.Path)
+	if err != nil {
+		config.Exit(testcases.Abort(config.NewExitError(config.ExitConfigurationError, err)))
+	}
+
+	if err := config.Configure(basePath); err != nil {
+		config.Exit(testcases.Abort(config.NewExitError(config.ExitConfigurationError, err)))
+	}
+
+	if config.Args.PprofPort > 0 {
+		go func() {
+			fmt.Println(http.ListenAndServe(fmt.Sprintf("127.0.0.1:%d", config.Args.PprofPort), nil))
+		}()
+	}
+
+	config.Exit(testcases.Execute())
+}
@@ -0,0 +1,143 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+const (
+	// CompletedEvent - fired when the test suite has finished executing
+	CompletedEvent = "completed"
+
+	// FirstFailureEvent - fired when the first test case of the run fails
+	FirstFailureEvent = "first_failure"
+)
+
+// Notification - the data sent to the notification sinks and passed to the sink templates
+type Notification struct {
+	Event      string    `json:"event"`
+	Network    string    `json:"network"`
+	Mode       string    `json:"mode"`
+	Version    string    `json:"version"`
+	Commit     string    `json:"commit"`
+	Run        string    `json:"run"`
+	StartedAt  time.Time `json:"started_at"`
+	Duration   string    `json:"duration"`
+	Executed   int       `json:"executed"`
+	Successful int       `json:"successful"`
+	Failed     int       `json:"failed"`
+	Dismissed  int       `json:"dismissed"`
+	Failures   []Failure `json:"failures"`
+	TestCase   *Failure  `json:"test_case,omitempty"`
+}
+
+// Failure - a failed test case
+type Failure struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Scenario string `json:"scenario"`
+	Error    string `json:"error"`
+}
+
+// Completed - the notification for a finished test suite, the counts are the ones computed for the summary
+func Completed(results []*testing.TestCase, dismissed []*testing.TestCase, successfulCount int, failedCount int, duration time.Duration) Notification {
+	notification := newNotification(CompletedEvent, duration)
+	notification.Executed = len(results)
+	notification.Successful = successfulCount
+	notification.Failed = failedCount
+	notification.Dismissed = len(dismissed)
+
+	for _, testCase := range results {
+		if !testCase.Successful() {
+			notification.Failures = append(notification.Failures, failure(testCase))
+		}
+	}
+
+	return notification
+}
+
+// FirstFailure - the notification for the first failed test case of the run
+func FirstFailure(testCase *testing.TestCase, executed int) Notification {
+	notification := newNotification(FirstFailureEvent, time.Now().UTC().Sub(config.Configuration.Framework.StartTime))
+	notification.Executed = executed
+	notification.Successful = executed - 1
+	notification.Failed = 1
+
+	testCaseFailure := failure(testCase)
+	notification.TestCase = &testCaseFailure
+	notification.Failures = []Failure{testCaseFailure}
+
+	return notification
+}
+
+// HasFailures - whether or not any test case has failed
+func (notification Notification) HasFailures() bool {
+	return notification.Failed > 0
+}
+
+// Status - passed or failed
+func (notification Notification) Status() string {
+	if notification.HasFailures() {
+		return "failed"
+	}
+
+	return "passed"
+}
+
+// PassRate - the pass rate (in percent) of the executed test cases
+func (notification Notification) PassRate() float64 {
+	if notification.Executed == 0 {
+		return 0
+	}
+
+	return float64(notification.Successful) / float64(notification.Executed) * 100
+}
+
+// Notify - sends a notification to all sinks subscribed to its event
+func Notify(notification Notification) error {
+	failures := []string{}
+
+	for index := range config.Configuration.Notifications.Sinks {
+		sink := &config.Configuration.Notifications.Sinks[index]
+		if !sink.Subscribes(notification.Event, notification.HasFailures()) {
+			continue
+		}
+
+		if err := Send(sink, notification); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", sink.Name, err.Error()))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to notify %d sink(s) - %s", len(failures), strings.Join(failures, ", "))
+	}
+
+	return nil
+}
+
+func newNotification(event string, duration time.Duration) Notification {
+	return Notification{
+		Event:     event,
+		Network:   config.Configuration.Network.Name,
+		Mode:      config.Configuration.Network.Mode,
+		Version:   config.Configuration.Framework.Version,
+		Commit:    config.Commit,
+		Run:       config.Configuration.Framework.Run,
+		StartedAt: config.Configuration.Framework.StartTime,
+		Duration:  duration.Round(time.Second).String(),
+		Failures:  []Failure{},
+	}
+}
+
+func failure(testCase *testing.TestCase) Failure {
+	return Failure{
+		Name:     testCase.Name,
+		Category: testCase.Category,
+		Scenario: testCase.Scenario,
+		Error:    testCase.ErrorMessage(),
+	}
+}
@@ -0,0 +1,246 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/utils"
+)
+
+const (
+	defaultSlackTemplate = `{{if .TestCase}}:warning: *Harmony TF* - test case {{.TestCase.Name}} failed on {{.Network}} ({{.Mode}} mode): {{.TestCase.Error}}{{else}}{{if .HasFailures}}:x:{{else}}:white_check_mark:{{end}} *Harmony TF* - run {{.Run}} {{.Status}} on {{.Network}} ({{.Mode}} mode): {{.Successful}}/{{.Executed}} test case(s) passed, {{.Dismissed}} dismissed, took {{.Duration}}{{range .Failures}}
+- {{.Name}}: {{.Error}}{{end}}{{end}}`
+
+	defaultEmailTemplate = `Harmony TF v{{.Version}} ({{.Commit}}) - run {{.Run}} on {{.Network}} ({{.Mode}} mode)
+Started at: {{.StartedAt.Format "2006-01-02 15:04:05 MST"}}
+Duration: {{.Duration}}
+{{if .TestCase}}
+Test case {{.TestCase.Name}} ({{.TestCase.Category}}) failed: {{.TestCase.Error}}
+{{else}}
+Status: {{.Status}}
+Successful: {{.Successful}}
+Failed: {{.Failed}}
+Dismissed: {{.Dismissed}}
+Pass rate: {{printf "%.1f" .PassRate}}%
+{{if .Failures}}
+Failed test cases:
+{{range .Failures}}- {{.Name}} ({{.Category}}): {{.Error}}
+{{end}}{{end}}{{end}}`
+
+	defaultSubjectTemplate = `Harmony TF - {{if .TestCase}}{{.TestCase.Name}} failed{{else}}run {{.Status}}: {{.Successful}}/{{.Executed}} passed{{end}} on {{.Network}}`
+)
+
+// Send - sends a notification to a given sink
+func Send(sink *config.NotificationSink, notification Notification) error {
+	switch sink.Type {
+	case "webhook":
+		return sendWebhook(sink, notification)
+	case "slack":
+		return sendSlack(sink, notification)
+	case "smtp":
+		return sendEmail(sink, notification)
+	default:
+		return fmt.Errorf("unknown sink type %s", sink.Type)
+	}
+}
+
+// sendWebhook - posts the notification as JSON, or the rendered template if the sink declares one
+func sendWebhook(sink *config.NotificationSink, notification Notification) error {
+	body, err := render(sink, "", notification)
+	if err != nil {
+		return err
+	}
+
+	if body == "" {
+		payload, err := json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+		body = string(payload)
+	}
+
+	return post(sink, body)
+}
+
+// sendSlack - posts a Slack compatible incoming webhook payload, templates rendering a JSON object are posted as is (e.g. to use blocks)
+func sendSlack(sink *config.NotificationSink, notification Notification) error {
+	text, err := render(sink, defaultSlackTemplate, notification)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(text), "{") {
+		return post(sink, text)
+	}
+
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	return post(sink, string(payload))
+}
+
+func post(sink *config.NotificationSink, body string) error {
+	request, err := http.NewRequest(http.MethodPost, sink.URL, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range sink.Headers {
+		request.Header.Set(key, value)
+	}
+
+	client := &http.Client{Timeout: time.Duration(sink.Timeout) * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		responseBody, _ := ioutil.ReadAll(response.Body)
+		return fmt.Errorf("%s responded with %s %s", sink.URL, response.Status, strings.TrimSpace(string(responseBody)))
+	}
+
+	return nil
+}
+
+// sendEmail - sends the notification as a plain text email, STARTTLS is used when the server supports it
+func sendEmail(sink *config.NotificationSink, notification Notification) error {
+	body, err := render(sink, defaultEmailTemplate, notification)
+	if err != nil {
+		return err
+	}
+
+	subjectTemplate := sink.SMTP.Subject
+	if subjectTemplate == "" {
+		subjectTemplate = defaultSubjectTemplate
+	}
+
+	subject, err := execute(sink.Name+"-subject", subjectTemplate, notification)
+	if err != nil {
+		return err
+	}
+
+	address := net.JoinHostPort(sink.SMTP.Host, strconv.Itoa(sink.SMTP.Port))
+	timeout := time.Duration(sink.Timeout) * time.Second
+
+	connection, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return err
+	}
+	connection.SetDeadline(time.Now().Add(timeout))
+
+	client, err := smtp.NewClient(connection, sink.SMTP.Host)
+	if err != nil {
+		connection.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: sink.SMTP.Host}); err != nil {
+			return err
+		}
+	}
+
+	if sink.SMTP.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", sink.SMTP.Username, sink.SMTP.Password, sink.SMTP.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(sink.SMTP.From); err != nil {
+		return err
+	}
+
+	for _, recipient := range sink.SMTP.To {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", sink.SMTP.From)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(sink.SMTP.To, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", strings.TrimSpace(strings.ReplaceAll(subject, "\n", " ")))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	message.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	if _, err := writer.Write(message.Bytes()); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// render - renders the sink template (template_file takes precedence over template), falls back to a given default template
+func render(sink *config.NotificationSink, defaultTemplate string, notification Notification) (string, error) {
+	text := sink.Template
+
+	if sink.TemplateFile != "" {
+		contents, err := utils.ReadFileToString(sink.TemplateFile)
+		if err != nil {
+			return "", err
+		}
+		text = contents
+	}
+
+	if text == "" {
+		text = defaultTemplate
+	}
+
+	if text == "" {
+		return "", nil
+	}
+
+	return execute(sink.Name, text, notification)
+}
+
+func execute(name string, text string, notification Notification) (string, error) {
+	parsed, err := template.New(name).Funcs(template.FuncMap{"json": toJSON}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var output bytes.Buffer
+	if err := parsed.Execute(&output, notification); err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
+
+// toJSON - JSON encodes a value, used for embedding values in JSON templates
+func toJSON(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,120 @@
+package notifications
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StandIn - stand-in HTTP and SMTP servers printing every notification they receive
+// It's meant for verifying the notification sinks locally and shouldn't be exposed on a public interface
+type StandIn struct {
+	Output io.Writer
+	lock   sync.Mutex
+}
+
+// ServeHTTP - prints webhook and Slack payloads
+func (standIn *StandIn) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	standIn.print(fmt.Sprintf("HTTP %s %s (%s)", request.Method, request.URL.Path, request.Header.Get("Content-Type")), string(body))
+
+	writer.WriteHeader(http.StatusOK)
+	writer.Write([]byte("ok"))
+}
+
+// ServeSMTP - accepts SMTP connections on a given listener and prints every received email
+func (standIn *StandIn) ServeSMTP(listener net.Listener) error {
+	for {
+		connection, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go standIn.handleSMTP(connection)
+	}
+}
+
+func (standIn *StandIn) handleSMTP(connection net.Conn) {
+	defer connection.Close()
+	connection.SetDeadline(time.Now().Add(5 * time.Minute))
+
+	conn := textproto.NewConn(connection)
+	conn.PrintfLine("220 harmony-tf stand-in ESMTP")
+
+	from := ""
+	recipients := []string{}
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		if index := strings.Index(command, " "); index >= 0 {
+			command = command[:index]
+		}
+
+		switch command {
+		case "EHLO", "HELO":
+			conn.PrintfLine("250 harmony-tf")
+		case "MAIL":
+			from = smtpArgument(line)
+			recipients = []string{}
+			conn.PrintfLine("250 OK")
+		case "RCPT":
+			recipients = append(recipients, smtpArgument(line))
+			conn.PrintfLine("250 OK")
+		case "DATA":
+			conn.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := conn.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			standIn.print(fmt.Sprintf("SMTP from %s to %s", from, strings.Join(recipients, ", ")), string(data))
+			conn.PrintfLine("250 OK")
+		case "RSET":
+			from = ""
+			recipients = []string{}
+			conn.PrintfLine("250 OK")
+		case "NOOP":
+			conn.PrintfLine("250 OK")
+		case "QUIT":
+			conn.PrintfLine("221 Bye")
+			return
+		default:
+			conn.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func (standIn *StandIn) print(title string, body string) {
+	standIn.lock.Lock()
+	defer standIn.lock.Unlock()
+
+	fmt.Fprintf(standIn.Output, "\n%s - %s:\n%s\n%s\n%s\n", time.Now().UTC().Format(time.RFC3339), title, strings.Repeat("-", 50), strings.TrimSpace(body), strings.Repeat("-", 50))
+}
+
+func smtpArgument(line string) string {
+	if index := strings.Index(line, ":"); index >= 0 {
+		line = line[index+1:]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.Trim(fields[0], "<>")
+}
@@ -61,7 +61,17 @@ func SendGenericTransaction(testCase *testing.TestCase, senderAccount *sdkAccoun
 }
 
 // GenerateEthereumChainID - map a network name and a shard ID to the corresponding Ethereum version
+// Chain ids declared in the network profile take precedence over the built-in networks
 func GenerateEthereumChainID(networkName string, shardID uint32) *common.ChainID {
+	profile := config.Configuration.Network.Profile
+	if profile == nil || !profile.Matches(networkName) {
+		profile, _ = config.FindNetworkProfile(networkName)
+	}
+
+	if chainID := profile.ChainID("eth", shardID); chainID != nil {
+		return chainID
+	}
+
 	switch networkName {
 	case "mainnet":
 		return &common.ChainID{Name: "eth_mainnet", Value: EthereumChainIDForShard(EthMainnetChainID, shardID)}
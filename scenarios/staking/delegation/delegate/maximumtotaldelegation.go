@@ -0,0 +1,99 @@
+package delegate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// MaximumTotalDelegationScenario - executes a delegation test case that delegates exactly up to (or one unit past) a validator's maximum total delegation
+// The delegation amount is calculated using the live validator information, i.e. max total delegation - current total delegation
+// Set the staking mode to exceed_maximum_total_delegation to delegate one unit more than what the validator can accept
+func MaximumTotalDelegationScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	fundingMultiple := int64(1)
+	_, _, err := funding.CalculateFundingDetails(testCase.StakingParameters.Create.Validator.Amount, fundingMultiple, 0)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	validatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Validator")
+	account, validator, err := staking.ReuseOrCreateValidator(testCase, validatorName)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create validator using account %s", validatorName)
+		testCase.HandleError(err, account, msg)
+		return
+	}
+
+	if validator.Exists {
+		remaining, validatorInfo, err := staking.RemainingDelegationCapacity(validator.Account.Address, testCase.StakingParameters.FromShardID)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to retrieve validator info for validator %s", validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+
+		logger.StakingLog(fmt.Sprintf("Validator %s has a maximum total delegation of %f and a current total delegation of %f - remaining capacity: %f", validator.Account.Address, validatorInfo.Validator.MaxTotalDelegation, validatorInfo.TotalDelegation, remaining), testCase.Verbose)
+
+		amount := remaining
+		if testCase.StakingParameters.Mode == "exceed_maximum_total_delegation" {
+			amount = amount.Add(staking.DelegationUnit)
+			logger.StakingLog(fmt.Sprintf("Will delegate one unit more than the remaining capacity: %f", amount), testCase.Verbose)
+		}
+
+		testCase.StakingParameters.Delegation.Delegate.Amount = amount
+		testCase.StakingParameters.Delegation.Delegate.RawAmount = amount.String()
+		testCase.StakingParameters.Delegation.Amount = amount
+
+		_, _, err = funding.CalculateFundingDetails(amount, fundingMultiple, 0)
+		if err != nil {
+			msg := fmt.Sprintf("Insufficient funds to delegate %f to validator %s", amount, validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+
+		delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
+		delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, amount, fundingMultiple)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to generate and fund account %s", delegatorName)
+			testCase.HandleError(err, &delegatorAccount, msg)
+			return
+		}
+
+		delegationTx, delegationSucceeded, err := staking.BasicDelegation(testCase, &delegatorAccount, validator.Account, nil)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to delegate from account %s, address %s to validator %s, address: %s", delegatorAccount.Name, delegatorAccount.Address, validator.Account.Name, validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+		testCase.Transactions = append(testCase.Transactions, delegationTx)
+
+		testCase.Result = delegationTx.Success && delegationSucceeded
+
+		logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+		testing.Teardown(&delegatorAccount, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
+	}
+
+	if !testCase.StakingParameters.ReuseExistingValidator {
+		staking.DisableValidator(validator.Account, &testCase.StakingParameters)
+		testing.Teardown(validator.Account, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
+	}
+
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
@@ -0,0 +1,93 @@
+package delegate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// NonBeaconShardScenario - executes a delegation test case where the validator is created on the beacon chain and the delegation tx is sent to a non-beacon shard
+// Staking txs are only accepted on the beacon chain - the delegation is expected to be rejected
+func NonBeaconShardScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+
+	delegationShardID := testCase.StakingParameters.FromShardID
+	if delegationShardID == 0 {
+		testCase.ReportShardDismissal()
+		return
+	}
+
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	fundingMultiple := int64(1)
+	_, _, err := funding.CalculateFundingDetails(testCase.StakingParameters.Create.Validator.Amount, fundingMultiple, 0)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	// The validator itself has to be created on the beacon chain
+	useShard(testCase, 0)
+
+	validatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Validator")
+	account, validator, err := staking.ReuseOrCreateValidator(testCase, validatorName)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create validator using account %s", validatorName)
+		testCase.HandleError(err, account, msg)
+		return
+	}
+
+	if validator.Exists {
+		useShard(testCase, delegationShardID)
+
+		delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
+		delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to generate and fund account %s", delegatorName)
+			testCase.HandleError(err, &delegatorAccount, msg)
+			return
+		}
+
+		logger.StakingLog(fmt.Sprintf("Will send the delegation transaction to shard %d", delegationShardID), testCase.Verbose)
+
+		delegationTx, delegationSucceeded, err := staking.BasicDelegation(testCase, &delegatorAccount, validator.Account, nil)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to delegate from account %s, address %s to validator %s, address: %s in shard %d", delegatorAccount.Name, delegatorAccount.Address, validator.Account.Name, validator.Account.Address, delegationShardID)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+		testCase.Transactions = append(testCase.Transactions, delegationTx)
+
+		testCase.Result = delegationTx.Success && delegationSucceeded
+
+		logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+		testing.Teardown(&delegatorAccount, delegationShardID, config.Configuration.Funding.Account.Address, 0)
+
+		useShard(testCase, 0)
+	}
+
+	if !testCase.StakingParameters.ReuseExistingValidator {
+		staking.DisableValidator(validator.Account, &testCase.StakingParameters)
+		testing.Teardown(validator.Account, 0, config.Configuration.Funding.Account.Address, 0)
+	}
+
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
+
+func useShard(testCase *testing.TestCase, shardID uint32) {
+	testCase.StakingParameters.FromShardID = shardID
+	testCase.StakingParameters.ToShardID = shardID
+}
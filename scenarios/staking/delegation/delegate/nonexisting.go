@@ -37,7 +37,7 @@ func NonExistingScenario(testCase *testing.TestCase) {
 	}
 
 	delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
-	delegatorAccount, err := testing.GenerateAndFundAccount(testCase, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
+	delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
 	if err != nil {
 		msg := fmt.Sprintf("Failed to generate and fund %s account", delegatorName)
 		testCase.HandleError(err, &delegatorAccount, msg)
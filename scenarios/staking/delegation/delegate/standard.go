@@ -39,7 +39,7 @@ func StandardScenario(testCase *testing.TestCase) {
 
 	if validator.Exists {
 		delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
-		delegatorAccount, err := testing.GenerateAndFundAccount(testCase, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
+		delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
 		if err != nil {
 			msg := fmt.Sprintf("Failed to fetch latest account balance for the account %s, address: %s", delegatorAccount.Name, delegatorAccount.Address)
 			testCase.HandleError(err, &delegatorAccount, msg)
@@ -57,6 +57,7 @@ func StandardScenario(testCase *testing.TestCase) {
 		testCase.Result = delegationTx.Success && delegationSucceeded
 
 		logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+		staking.ReleaseFixtureDelegation(testCase, &delegatorAccount, validator.Account)
 		testing.Teardown(&delegatorAccount, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
 	}
 
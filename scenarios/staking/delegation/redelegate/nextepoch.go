@@ -45,7 +45,7 @@ func NextEpochScenario(testCase *testing.TestCase) {
 
 	if validator.Exists {
 		delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
-		delegatorAccount, err := testing.GenerateAndFundAccount(testCase, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
+		delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, testCase.StakingParameters.Delegation.Amount, fundingMultiple)
 		if err != nil {
 			msg := fmt.Sprintf("Failed to fetch latest account balance for the account %s, address: %s", delegatorAccount.Name, delegatorAccount.Address)
 			testCase.HandleError(err, &delegatorAccount, msg)
@@ -139,7 +139,8 @@ func NextEpochScenario(testCase *testing.TestCase) {
 			testCase.HandleError(err, validator.Account, msg)
 			return
 		}
-		// TODO: Use param for timeout waiting for next epoch (set for current Harmony Testnet)
+		// The network profile's epoch length and block time determine how long to wait for the next epoch (defaults to the current Harmony Testnet)
+		epochWaitTime := time.Duration(config.Configuration.Network.Profile.EpochWaitTime(900)) * time.Second
 		loopStart := time.Now().UTC()
 		for true {
 			epochCheck, err := config.Configuration.Network.API.CurrentEpoch(testCase.StakingParameters.FromShardID)
@@ -152,7 +153,7 @@ func NextEpochScenario(testCase *testing.TestCase) {
 			time.Sleep(time.Duration(20) * time.Second)
 			temp := time.Now().UTC()
 			loopDuration := temp.Sub(loopStart)
-			if loopDuration > (time.Duration(900) * time.Second) {
+			if loopDuration > epochWaitTime {
 				msg := fmt.Sprintf("Have not reach next epoch before timeout %d seconds", loopDuration.Seconds())
 				testCase.HandleError(err, validator.Account, msg)
 				return
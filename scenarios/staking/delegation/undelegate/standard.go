@@ -39,7 +39,7 @@ func StandardScenario(testCase *testing.TestCase) {
 
 	if validator.Exists {
 		delegatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Delegator")
-		delegatorAccount, err := testing.GenerateAndFundAccount(testCase, delegatorName, testCase.StakingParameters.Delegation.Amount, 1)
+		delegatorAccount, err := testing.AcquireOrGenerateAccount(testCase, testCase.Fixtures.Delegators, delegatorName, testCase.StakingParameters.Delegation.Amount, 1)
 		if err != nil {
 			msg := fmt.Sprintf("Failed to generate and fund account %s", delegatorName)
 			testCase.HandleError(err, &delegatorAccount, msg)
@@ -0,0 +1,68 @@
+package create
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// BelowMinimumSelfDelegationScenario - executes a create validator test case where the self delegation is one unit below the network minimum
+func BelowMinimumSelfDelegationScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	amount := staking.NetworkMinimumSelfDelegation.Sub(staking.DelegationUnit)
+	testCase.StakingParameters.Create.Validator.Amount = amount
+	testCase.StakingParameters.Create.Validator.MinimumSelfDelegation = amount
+	if testCase.StakingParameters.Create.Validator.MaximumTotalDelegation.IsNil() || testCase.StakingParameters.Create.Validator.MaximumTotalDelegation.LT(amount) {
+		testCase.StakingParameters.Create.Validator.MaximumTotalDelegation = staking.NetworkMinimumSelfDelegation
+	}
+	logger.StakingLog(fmt.Sprintf("Will create the validator using a self delegation and minimum self delegation of %f - the network minimum is %f", amount, staking.NetworkMinimumSelfDelegation), testCase.Verbose)
+
+	fundingMultiple := int64(1)
+	_, _, err := funding.CalculateFundingDetails(amount, fundingMultiple, 0)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	validatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Validator")
+	account, err := testing.GenerateAndFundAccount(testCase, validatorName, amount, fundingMultiple)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to generate and fund account %s", validatorName)
+		testCase.HandleError(err, &account, msg)
+		return
+	}
+
+	testCase.StakingParameters.Create.Validator.Account = &account
+	tx, _, validatorExists, err := staking.BasicCreateValidator(testCase, &account, nil, nil)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create validator using account %s, address: %s", account.Name, account.Address)
+		testCase.HandleError(err, &account, msg)
+		return
+	}
+	testCase.Transactions = append(testCase.Transactions, tx)
+
+	testCase.Result = tx.Success && validatorExists
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	if validatorExists {
+		staking.DisableValidator(&account, &testCase.StakingParameters)
+	}
+	testing.Teardown(&account, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
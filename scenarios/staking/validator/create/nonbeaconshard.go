@@ -0,0 +1,69 @@
+package create
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// NonBeaconShardScenario - executes a create validator test case where the create validator tx is sent to a non-beacon shard
+// Staking txs are only accepted on the beacon chain - the tx is expected to be rejected
+func NonBeaconShardScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+
+	if testCase.StakingParameters.FromShardID == 0 {
+		testCase.ReportShardDismissal()
+		return
+	}
+
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	fundingMultiple := int64(1)
+	_, _, err := funding.CalculateFundingDetails(testCase.StakingParameters.Create.Validator.Amount, fundingMultiple, 0)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	validatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Validator")
+	account, err := testing.GenerateAndFundAccount(testCase, validatorName, testCase.StakingParameters.Create.Validator.Amount, fundingMultiple)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to generate and fund account %s", validatorName)
+		testCase.HandleError(err, &account, msg)
+		return
+	}
+
+	logger.StakingLog(fmt.Sprintf("Will send the create validator transaction to shard %d", testCase.StakingParameters.FromShardID), testCase.Verbose)
+
+	testCase.StakingParameters.Create.Validator.Account = &account
+	tx, _, validatorExists, err := staking.BasicCreateValidator(testCase, &account, nil, nil)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create validator using account %s, address: %s in shard %d", account.Name, account.Address, testCase.StakingParameters.FromShardID)
+		testCase.HandleError(err, &account, msg)
+		return
+	}
+	testCase.Transactions = append(testCase.Transactions, tx)
+
+	testCase.Result = tx.Success && validatorExists
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	if validatorExists {
+		staking.DisableValidator(&account, &testCase.StakingParameters)
+	}
+	testing.Teardown(&account, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
@@ -0,0 +1,97 @@
+package edit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/staking"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+// LowerMaximumTotalDelegationScenario - executes an edit validator test case that lowers the maximum total delegation below the validator's current total delegation
+func LowerMaximumTotalDelegationScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	_, _, err := funding.CalculateFundingDetails(testCase.StakingParameters.Create.Validator.Amount, 1, 0)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	validatorName := accounts.GenerateTestCaseAccountName(testCase.Name, "Validator")
+	account, validator, err := staking.ReuseOrCreateValidator(testCase, validatorName)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create validator using account %s", validatorName)
+		testCase.HandleError(err, account, msg)
+		return
+	}
+
+	if validator.Exists {
+		validatorInfo, err := staking.ValidatorInformation(validator.Account.Address, testCase.StakingParameters.FromShardID)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to retrieve validator info for validator %s", validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+
+		if validatorInfo.TotalDelegation.IsNil() {
+			err = fmt.Errorf("failed to retrieve the total delegation for validator %s", validator.Account.Address)
+			testCase.HandleError(err, validator.Account, err.Error())
+			return
+		}
+
+		minimumSelfDelegation := validator.MinimumSelfDelegation
+		if minimumSelfDelegation.IsNil() || !validatorInfo.TotalDelegation.GT(minimumSelfDelegation) {
+			err = fmt.Errorf("the total delegation of validator %s has to exceed its minimum self delegation - increase the validator amount of the test case", validator.Account.Address)
+			testCase.HandleError(err, validator.Account, err.Error())
+			return
+		}
+
+		// Halfway between the minimum self delegation and the total delegation - the edit should only be rejected for undercutting the total delegation, not the minimum self delegation
+		maximumTotalDelegation := minimumSelfDelegation.Add(validatorInfo.TotalDelegation.Sub(minimumSelfDelegation).QuoInt64(2))
+		testCase.StakingParameters.Edit.Validator.MaximumTotalDelegation = maximumTotalDelegation
+		testCase.StakingParameters.Edit.Validator.RawMaximumTotalDelegation = maximumTotalDelegation.String()
+		logger.StakingLog(fmt.Sprintf("Validator %s has a current total delegation of %f - will attempt to lower the maximum total delegation to %f", validator.Account.Address, validatorInfo.TotalDelegation, maximumTotalDelegation), testCase.Verbose)
+
+		editTx, err := staking.BasicEditValidator(testCase, validator.Account, nil, nil, nil)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to edit validator using account %s, address: %s", validator.Account.Name, validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+		testCase.Transactions = append(testCase.Transactions, editTx)
+
+		validatorInfo, err = staking.ValidatorInformation(validator.Account.Address, testCase.StakingParameters.FromShardID)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to retrieve validator info for validator %s", validator.Account.Address)
+			testCase.HandleError(err, validator.Account, msg)
+			return
+		}
+
+		successfullyUpdated := testCase.StakingParameters.Edit.EvaluateChanges(validatorInfo.Validator, testCase.Verbose)
+		editValidatorColoring := logger.ResultColoring(successfullyUpdated, true)
+		logger.StakingLog(fmt.Sprintf("Validator successfully edited: %s", editValidatorColoring), testCase.Verbose)
+
+		testCase.Result = editTx.Success && successfullyUpdated
+	}
+
+	if !testCase.StakingParameters.ReuseExistingValidator {
+		logger.TeardownLog("Performing test teardown (returning funds and removing accounts)", testCase.Verbose)
+		staking.DisableValidator(validator.Account, &testCase.StakingParameters)
+		testing.Teardown(validator.Account, testCase.StakingParameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.StakingParameters.FromShardID)
+	}
+
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
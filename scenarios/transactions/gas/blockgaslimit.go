@@ -0,0 +1,76 @@
+package gas
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/rpc"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+// ExceedsBlockGasLimitScenario - sends a data heavy transaction that can't fit into a block
+// Payloads larger than the maximum pool tx size are expected to be rejected with core.ErrOversizedData (the pool checks the size before the gas limit)
+// Smaller payloads are expected to be rejected with core.ErrGasLimit - those test cases have to use an explicit gas limit above the block gas limit
+// Test cases without an explicit gas limit use the intrinsic gas of the payload
+func ExceedsBlockGasLimitScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	txData := testCase.Parameters.GenerateTxData()
+	encodedDataSize := base64.StdEncoding.EncodedLen(len(txData))
+
+	requiredGas, err := intrinsicGas(txData)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	blockGasLimit, err := transactions.BlockGasLimit(testCase.Parameters.FromShardID)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	if testCase.Parameters.Gas.Limit <= 0 {
+		testCase.Parameters.Gas.Limit = int64(requiredGas)
+	}
+
+	var expectedErr error
+	switch {
+	case encodedDataSize >= types.MaxPoolTransactionDataSize:
+		expectedErr = core.ErrOversizedData
+	case uint64(testCase.Parameters.Gas.Limit) > blockGasLimit:
+		expectedErr = core.ErrGasLimit
+	default:
+		testCase.ErrorOccurred(fmt.Errorf("a gas limit of %d fits into the block gas limit of %d and %d byte(s) of encoded tx data fit into the tx pool - use a gas limit above the block gas limit", testCase.Parameters.Gas.Limit, blockGasLimit, encodedDataSize))
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	logger.TransactionLog(fmt.Sprintf("Sending transaction from %s to %s using a gas limit of %d and %d byte(s) of encoded tx data (intrinsic gas: %d) - block gas limit: %d, maximum pool tx size: %d byte(s)", senderAccount.Address, receiverAccount.Address, testCase.Parameters.Gas.Limit, encodedDataSize, requiredGas, blockGasLimit, types.MaxPoolTransactionDataSize), testCase.Verbose)
+
+	testCaseTx := rpc.SendTransaction(testCase, &senderAccount, &receiverAccount)
+	testCase.Transactions = append(testCase.Transactions, testCaseTx)
+
+	testCase.Result = testCase.ExpectRejection("Transaction", testCaseTx.Error, expectedErr)
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
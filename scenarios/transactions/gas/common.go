@@ -0,0 +1,18 @@
+package gas
+
+import (
+	"encoding/base64"
+
+	"github.com/harmony-one/harmony/core"
+)
+
+// intrinsicGas - calculates the intrinsic gas the network will require for a given tx data payload
+// The tx data is base64 encoded before it gets sent, so the gas has to be calculated using the encoded payload
+func intrinsicGas(txData string) (uint64, error) {
+	var data []byte
+	if len(txData) > 0 {
+		data = []byte(base64.StdEncoding.EncodeToString([]byte(txData)))
+	}
+
+	return core.IntrinsicGas(data, false, true, true, false)
+}
@@ -0,0 +1,48 @@
+package gas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/rpc"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony/core"
+)
+
+// BelowIntrinsicGasScenario - sends a transaction using a gas limit one unit below the intrinsic gas - the tx should be rejected with core.ErrIntrinsicGas
+func BelowIntrinsicGasScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	requiredGas, err := intrinsicGas(testCase.Parameters.GenerateTxData())
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	testCase.Parameters.Gas.Limit = int64(requiredGas) - 1
+	logger.TransactionLog(fmt.Sprintf("Sending transaction from %s to %s using a gas limit of %d - intrinsic gas: %d", senderAccount.Address, receiverAccount.Address, testCase.Parameters.Gas.Limit, requiredGas), testCase.Verbose)
+
+	testCaseTx := rpc.SendTransaction(testCase, &senderAccount, &receiverAccount)
+	testCase.Transactions = append(testCase.Transactions, testCaseTx)
+
+	testCase.Result = testCase.ExpectRejection("Transaction", testCaseTx.Error, core.ErrIntrinsicGas)
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
@@ -0,0 +1,130 @@
+package gas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/numeric"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkNetworkNonce "github.com/harmony-one/go-lib/network/rpc/nonces"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+)
+
+// HigherPriceReplacementScenario - replaces a pending transaction using the same nonce and a sufficiently bumped gas price - the replacement should be accepted and finalized
+func HigherPriceReplacementScenario(testCase *testing.TestCase) {
+	replacementScenario(testCase, "higher")
+}
+
+// LowerPriceReplacementScenario - replaces a pending transaction using the same nonce and a lower gas price - the replacement should be rejected with core.ErrReplaceUnderpriced
+func LowerPriceReplacementScenario(testCase *testing.TestCase) {
+	replacementScenario(testCase, "lower")
+}
+
+// SamePriceReplacementScenario - replaces a pending transaction using the same nonce and the same gas price - the replacement should be rejected with core.ErrReplaceUnderpriced
+func SamePriceReplacementScenario(testCase *testing.TestCase) {
+	replacementScenario(testCase, "same")
+}
+
+// replacementScenario - the original tx is sent using a nonce gap (current nonce + 1) so that it stays queued in the tx pool until it gets replaced
+// The gap is filled afterwards using a tx with the current nonce so that the queued tx can get finalized
+func replacementScenario(testCase *testing.TestCase, priceChange string) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 2)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	rpcClient, err := config.Configuration.Network.API.RPCClient(testCase.Parameters.FromShardID)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+	currentNonce := sdkNetworkNonce.CurrentNonce(rpcClient, senderAccount.Address)
+	queuedNonce := currentNonce + 1
+
+	originalGasPrice := testCase.Parameters.Gas.Price
+	var replacementGasPrice numeric.Dec
+
+	switch priceChange {
+	case "higher":
+		replacementGasPrice = sdkTxs.BumpGasPrice(originalGasPrice)
+	case "lower":
+		// The replacement price can't go below the minimum gas price accepted by the tx pool - bump the original price instead
+		originalGasPrice = sdkTxs.BumpGasPrice(sdkTxs.BumpGasPrice(originalGasPrice))
+		replacementGasPrice = testCase.Parameters.Gas.Price
+	default:
+		replacementGasPrice = originalGasPrice
+	}
+
+	logger.TransactionLog(fmt.Sprintf("Sending original transaction from %s to %s using nonce %d and gas price %f - it will stay queued until nonce %d has been used", senderAccount.Address, receiverAccount.Address, queuedNonce, originalGasPrice, currentNonce), testCase.Verbose)
+	originalTx := sendTransaction(testCase, &senderAccount, &receiverAccount, queuedNonce, originalGasPrice, 0)
+	testCase.Transactions = append(testCase.Transactions, originalTx)
+	if originalTx.Error != nil {
+		msg := fmt.Sprintf("Failed to send the original transaction from %s using nonce %d", senderAccount.Address, queuedNonce)
+		testCase.HandleError(originalTx.Error, &senderAccount, msg)
+		return
+	}
+
+	logger.TransactionLog(fmt.Sprintf("Sending replacement transaction from %s to %s using nonce %d and gas price %f", senderAccount.Address, receiverAccount.Address, queuedNonce, replacementGasPrice), testCase.Verbose)
+	replacementTx := sendTransaction(testCase, &senderAccount, &receiverAccount, queuedNonce, replacementGasPrice, 0)
+	testCase.Transactions = append(testCase.Transactions, replacementTx)
+
+	logger.TransactionLog(fmt.Sprintf("Sending transaction from %s to %s using nonce %d to fill the nonce gap", senderAccount.Address, receiverAccount.Address, currentNonce), testCase.Verbose)
+	gapTx := sendTransaction(testCase, &senderAccount, &receiverAccount, currentNonce, testCase.Parameters.Gas.Price, testCase.Parameters.Timeout)
+	testCase.Transactions = append(testCase.Transactions, gapTx)
+
+	if priceChange == "higher" {
+		if replacementTx.Error != nil {
+			logger.ErrorLog(fmt.Sprintf("Replacement transaction was rejected with the error: %s", replacementTx.Error.Error()), testCase.Verbose)
+		} else {
+			testCase.Result = waitForConfirmation(testCase, replacementTx.TransactionHash)
+		}
+	} else {
+		testCase.Result = testCase.ExpectRejection("Replacement transaction", replacementTx.Error, core.ErrReplaceUnderpriced)
+		waitForConfirmation(testCase, originalTx.TransactionHash)
+	}
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
+
+func sendTransaction(testCase *testing.TestCase, senderAccount *sdkAccounts.Account, receiverAccount *sdkAccounts.Account, nonce uint64, gasPrice numeric.Dec, timeout int) sdkTxs.Transaction {
+	rawTx, err := transactions.SendTransaction(senderAccount, testCase.Parameters.FromShardID, receiverAccount.Address, testCase.Parameters.ToShardID, testCase.Parameters.Amount, int(nonce), testCase.Parameters.Gas.Limit, gasPrice, "", timeout)
+	return sdkTxs.ToTransaction(senderAccount.Address, testCase.Parameters.FromShardID, receiverAccount.Address, testCase.Parameters.ToShardID, rawTx, err)
+}
+
+func waitForConfirmation(testCase *testing.TestCase, txHash string) bool {
+	rpcClient, err := config.Configuration.Network.API.RPCClient(testCase.Parameters.FromShardID)
+	if err != nil {
+		return false
+	}
+
+	response, err := sdkTxs.WaitForTxConfirmation(rpcClient, config.Configuration.Network.API.NodeAddress(testCase.Parameters.FromShardID), "transaction", txHash, testCase.Parameters.Timeout)
+	if err != nil || response == nil {
+		logger.TransactionLog(fmt.Sprintf("Transaction %s wasn't finalized within %d seconds", txHash, testCase.Parameters.Timeout), testCase.Verbose)
+		return false
+	}
+
+	success := sdkTxs.IsTransactionSuccessful(response)
+	successColoring := logger.ResultColoring(success, true)
+	logger.TransactionLog(fmt.Sprintf("Transaction %s was finalized - tx successful: %s", txHash, successColoring), testCase.Verbose)
+
+	return success
+}
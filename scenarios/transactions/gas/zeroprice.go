@@ -0,0 +1,45 @@
+package gas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/rpc"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// ZeroPriceScenario - sends a transaction using a gas price of 0 - the tx should be rejected with core.ErrUnderpriced
+func ZeroPriceScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	// Gas.Initialize forces a gas price of 0 to 1 - override it after initialization
+	testCase.Parameters.Gas.Price = numeric.NewDec(0)
+	logger.TransactionLog(fmt.Sprintf("Sending transaction from %s to %s using a gas price of %f", senderAccount.Address, receiverAccount.Address, testCase.Parameters.Gas.Price), testCase.Verbose)
+
+	testCaseTx := rpc.SendTransaction(testCase, &senderAccount, &receiverAccount)
+	testCase.Transactions = append(testCase.Transactions, testCaseTx)
+
+	testCase.Result = testCase.ExpectRejection("Transaction", testCaseTx.Error, core.ErrUnderpriced)
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
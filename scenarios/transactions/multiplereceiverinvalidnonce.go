@@ -52,17 +52,7 @@ func MultipleReceiverInvalidNonceScenario(testCase *testing.TestCase) {
 	}
 
 	logger.FundingLog(fmt.Sprintf("Funding sender account: %s, address: %s", senderAccount.Name, senderAccount.Address), testCase.Verbose)
-	funding.PerformFundingTransaction(
-		&config.Configuration.Funding.Account,
-		testCase.Parameters.FromShardID,
-		senderAccount.Address,
-		testCase.Parameters.ToShardID,
-		requiredFunding, -1,
-		config.Configuration.Funding.Gas.Limit,
-		config.Configuration.Funding.Gas.Price,
-		config.Configuration.Funding.Timeout,
-		config.Configuration.Funding.Retry.Attempts,
-	)
+	funding.FundAccount(testCase.Parameters.FromShardID, senderAccount.Address, testCase.Parameters.ToShardID, requiredFunding)
 
 	nameTemplate := accounts.GenerateTestCaseAccountName(testCase.Name, "Receiver_")
 	receiverAccounts := accounts.AsyncGenerateMultipleAccounts(nameTemplate, testCase.Parameters.ReceiverCount)
@@ -169,8 +159,8 @@ func multipleReceiversTeardown(testCase *testing.TestCase, senderAccount sdkAcco
 	waitGroup.Add(1 + len(receiverAccounts))
 
 	go testing.AsyncTeardown(&senderAccount, testCase.Parameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.ToShardID, &waitGroup)
-	for _, receiverAccount := range receiverAccounts {
-		go testing.AsyncTeardown(&receiverAccount, testCase.Parameters.ToShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
+	for index := range receiverAccounts {
+		go testing.AsyncTeardown(&receiverAccounts[index], testCase.Parameters.ToShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
 	}
 
 	waitGroup.Wait()
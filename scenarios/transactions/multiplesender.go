@@ -182,8 +182,8 @@ func multipleSendersTeardown(testCase *testing.TestCase, senderAccounts []sdkAcc
 	var waitGroup sync.WaitGroup
 	waitGroup.Add(1 + len(senderAccounts))
 
-	for _, senderAccount := range senderAccounts {
-		go testing.AsyncTeardown(&senderAccount, testCase.Parameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
+	for index := range senderAccounts {
+		go testing.AsyncTeardown(&senderAccounts[index], testCase.Parameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
 	}
 	go testing.AsyncTeardown(&receiverAccount, testCase.Parameters.ToShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
 
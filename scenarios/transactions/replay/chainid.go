@@ -0,0 +1,74 @@
+package replay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/rpc"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+// WrongNetworkChainIDScenario - signs a transaction using the chain id of another network (i.e. the mainnet chain id on testnet) - the tx should be rejected
+func WrongNetworkChainIDScenario(testCase *testing.TestCase) {
+	chainIDScenario(testCase, wrongNetworkChainID(testCase))
+}
+
+// WrongShardOffsetChainIDScenario - signs a transaction using an Ethereum chain id with the wrong shard offset - the tx should be rejected
+func WrongShardOffsetChainIDScenario(testCase *testing.TestCase) {
+	testCase.Parameters.RPCPrefix = "eth"
+	chainIDScenario(testCase, rpc.GenerateEthereumChainID(config.Configuration.Network.Name, testCase.Parameters.FromShardID+1))
+}
+
+func chainIDScenario(testCase *testing.TestCase, chainID *common.ChainID) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	logger.TransactionLog(fmt.Sprintf("Signing transaction from %s to %s using the chain id %s (%d) on network %s", senderAccount.Address, receiverAccount.Address, chainID.Name, chainID.Value, config.Configuration.Network.Name), testCase.Verbose)
+
+	config.Configuration.Network.ChangeRPCSettings(testCase.Parameters.RPCPrefix, chainID)
+	testCaseTx := rpc.SendGenericTransaction(testCase, &senderAccount, &receiverAccount)
+	config.Configuration.Network.RevertRPCSettings()
+
+	testCase.Transactions = append(testCase.Transactions, testCaseTx)
+	testCase.Result = testCase.ExpectRejection("Transaction", testCaseTx.Error, types.ErrInvalidChainID)
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
+
+// wrongNetworkChainID - uses the mainnet chain id on any other network and the testnet chain id on mainnet
+func wrongNetworkChainID(testCase *testing.TestCase) *common.ChainID {
+	networkName := "mainnet"
+	if config.Configuration.Network.Name == "mainnet" {
+		networkName = "testnet"
+	}
+
+	if testCase.Parameters.RPCPrefix == "eth" {
+		return rpc.GenerateEthereumChainID(networkName, testCase.Parameters.FromShardID)
+	}
+
+	if networkName == "mainnet" {
+		return &common.Chain.MainNet
+	}
+
+	return &common.Chain.TestNet
+}
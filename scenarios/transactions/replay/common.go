@@ -0,0 +1,11 @@
+package replay
+
+import "errors"
+
+var (
+	// errFinalizedTransaction - the error hmy.SendTx returns when a tx has already been finalized, it isn't exported by the node
+	errFinalizedTransaction = errors.New("transaction already finalized")
+
+	// errShardMismatch - the error node.AddPendingTransaction returns when a tx is sent to a node on a different shard, it isn't exported by the node
+	errShardMismatch = errors.New("shard do not match")
+)
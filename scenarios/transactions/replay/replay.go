@@ -0,0 +1,110 @@
+package replay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/core"
+
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+)
+
+// SameShardScenario - broadcasts a signed raw transaction and then replays the exact same raw transaction a second time - the replay should be rejected
+func SameShardScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	signedTx, err := transactions.SignTransaction(&senderAccount, nil, testCase.Parameters.FromShardID, receiverAccount.Address, testCase.Parameters.ToShardID, testCase.Parameters.Amount, testCase.Parameters.Nonce, testCase.Parameters.Gas.Limit, testCase.Parameters.Gas.Price, testCase.Parameters.GenerateTxData())
+	if err != nil {
+		testCase.HandleError(err, &senderAccount, fmt.Sprintf("Failed to sign transaction from %s", senderAccount.Address))
+		return
+	}
+
+	originalTx := sendRawTransaction(testCase, signedTx, testCase.Parameters.FromShardID, senderAccount.Address, receiverAccount.Address)
+	testCase.Transactions = append(testCase.Transactions, originalTx)
+	logger.TransactionLog(fmt.Sprintf("Sent the original transaction - transaction hash: %s, tx successful: %s", originalTx.TransactionHash, logger.ResultColoring(originalTx.Success, true)), testCase.Verbose)
+
+	logger.TransactionLog(fmt.Sprintf("Replaying the raw transaction %s on shard %d", originalTx.TransactionHash, testCase.Parameters.FromShardID), testCase.Verbose)
+	replayedTx := sendRawTransaction(testCase, signedTx, testCase.Parameters.FromShardID, senderAccount.Address, receiverAccount.Address)
+	testCase.Transactions = append(testCase.Transactions, replayedTx)
+
+	// Depending on how far the original tx has progressed the node will either report it as finalized, known (pending) or as using a nonce that's too low
+	replayRejected := testCase.ExpectRejection("Replayed transaction", replayedTx.Error, errFinalizedTransaction, core.ErrKnownTransaction, core.ErrNonceTooLow)
+	testCase.Result = originalTx.Success && replayRejected
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
+
+// CrossShardScenario - broadcasts a signed raw transaction on its own shard and then replays it on another shard - the replay should be rejected
+func CrossShardScenario(testCase *testing.TestCase) {
+	testing.Title(testCase, "header", testCase.Verbose)
+
+	if config.Configuration.Network.Shards < 2 {
+		testCase.ReportShardDismissal()
+		return
+	}
+
+	testCase.Executed = true
+	testCase.StartedAt = time.Now().UTC()
+
+	if testCase.ErrorOccurred(nil) {
+		return
+	}
+
+	replayShardID := (testCase.Parameters.FromShardID + 1) % uint32(config.Configuration.Network.Shards)
+
+	senderAccount, receiverAccount, err := testing.GenerateSenderAndReceiver(testCase, 1)
+	if testCase.ErrorOccurred(err) {
+		return
+	}
+
+	signedTx, err := transactions.SignTransaction(&senderAccount, nil, testCase.Parameters.FromShardID, receiverAccount.Address, testCase.Parameters.ToShardID, testCase.Parameters.Amount, testCase.Parameters.Nonce, testCase.Parameters.Gas.Limit, testCase.Parameters.Gas.Price, testCase.Parameters.GenerateTxData())
+	if err != nil {
+		testCase.HandleError(err, &senderAccount, fmt.Sprintf("Failed to sign transaction from %s", senderAccount.Address))
+		return
+	}
+
+	originalTx := sendRawTransaction(testCase, signedTx, testCase.Parameters.FromShardID, senderAccount.Address, receiverAccount.Address)
+	testCase.Transactions = append(testCase.Transactions, originalTx)
+	logger.TransactionLog(fmt.Sprintf("Sent the original transaction on shard %d - transaction hash: %s, tx successful: %s", testCase.Parameters.FromShardID, originalTx.TransactionHash, logger.ResultColoring(originalTx.Success, true)), testCase.Verbose)
+
+	logger.TransactionLog(fmt.Sprintf("Replaying the raw transaction %s on shard %d", originalTx.TransactionHash, replayShardID), testCase.Verbose)
+	replayedTx := sendRawTransaction(testCase, signedTx, replayShardID, senderAccount.Address, receiverAccount.Address)
+	testCase.Transactions = append(testCase.Transactions, replayedTx)
+
+	replayRejected := testCase.ExpectRejection(fmt.Sprintf("Transaction replayed on shard %d", replayShardID), replayedTx.Error, errShardMismatch, core.ErrInvalidShard)
+	testCase.Result = originalTx.Success && replayRejected
+
+	logger.TeardownLog("Performing test teardown (returning funds and removing accounts)\n", testCase.Verbose)
+	logger.ResultLog(testCase.Result, testCase.Expected, testCase.Verbose)
+
+	testing.TeardownSenderAndReceiver(testCase, senderAccount, receiverAccount)
+	testing.Title(testCase, "footer", testCase.Verbose)
+
+	testCase.FinishedAt = time.Now().UTC()
+}
+
+func sendRawTransaction(testCase *testing.TestCase, signedTx string, shardID uint32, senderAddress string, receiverAddress string) sdkTxs.Transaction {
+	rawTx, err := transactions.SendRawTransaction(signedTx, shardID, testCase.Parameters.Timeout)
+	return sdkTxs.ToTransaction(senderAddress, shardID, receiverAddress, testCase.Parameters.ToShardID, rawTx, err)
+}
@@ -36,18 +36,7 @@ func SameAccountScenario(testCase *testing.TestCase) {
 	}
 
 	logger.FundingLog(fmt.Sprintf("Funding account: %s, address: %s", account.Name, account.Address), testCase.Verbose)
-	funding.PerformFundingTransaction(
-		&config.Configuration.Funding.Account,
-		testCase.Parameters.FromShardID,
-		account.Address,
-		testCase.Parameters.FromShardID,
-		requiredFunding,
-		-1,
-		config.Configuration.Funding.Gas.Limit,
-		config.Configuration.Funding.Gas.Price,
-		config.Configuration.Funding.Timeout,
-		config.Configuration.Funding.Retry.Attempts,
-	)
+	funding.FundAccount(testCase.Parameters.FromShardID, account.Address, testCase.Parameters.FromShardID, requiredFunding)
 
 	senderStartingBalance, err := balances.GetShardBalance(account.Address, testCase.Parameters.FromShardID)
 	if testCase.ErrorOccurred(err) {
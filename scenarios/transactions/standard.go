@@ -39,18 +39,7 @@ func StandardScenario(testCase *testing.TestCase) {
 	}
 
 	logger.FundingLog(fmt.Sprintf("Funding sender account: %s, address: %s", senderAccount.Name, senderAccount.Address), testCase.Verbose)
-	funding.PerformFundingTransaction(
-		&config.Configuration.Funding.Account,
-		testCase.Parameters.FromShardID,
-		senderAccount.Address,
-		testCase.Parameters.FromShardID,
-		requiredFunding,
-		-1,
-		config.Configuration.Funding.Gas.Limit,
-		config.Configuration.Funding.Gas.Price,
-		config.Configuration.Funding.Timeout,
-		config.Configuration.Funding.Retry.Attempts,
-	)
+	funding.FundAccount(testCase.Parameters.FromShardID, senderAccount.Address, testCase.Parameters.FromShardID, requiredFunding)
 
 	receiverAccountName := accounts.GenerateTestCaseAccountName(testCase.Name, "Receiver")
 	logger.AccountLog(fmt.Sprintf("Generating a new receiver account: %s", receiverAccountName), testCase.Verbose)
@@ -0,0 +1,51 @@
+package signers
+
+import (
+	"math/big"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkErrors "github.com/harmony-one/go-lib/errors"
+	sdkStaking "github.com/harmony-one/go-lib/staking"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/harmony/core/types"
+	hmyStaking "github.com/harmony-one/harmony/staking/types"
+)
+
+// LocalSigner - signs transactions using the accounts imported into the local go-sdk keystore
+type LocalSigner struct{}
+
+// SignTransaction - signs a transaction using the local keystore
+func (signer *LocalSigner) SignTransaction(account *sdkAccounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if err := unlock(account); err != nil {
+		return nil, err
+	}
+
+	return sdkTxs.SignTransaction(account.Keystore, account.Account, tx, chainID)
+}
+
+// SignEthTransaction - signs an eth transaction using the local keystore
+func (signer *LocalSigner) SignEthTransaction(account *sdkAccounts.Account, tx *types.EthTransaction, chainID *big.Int) (*types.EthTransaction, error) {
+	if err := unlock(account); err != nil {
+		return nil, err
+	}
+
+	return sdkTxs.SignEthTransaction(account.Keystore, account.Account, tx, chainID)
+}
+
+// SignStakingTransaction - signs a staking transaction using the local keystore
+func (signer *LocalSigner) SignStakingTransaction(account *sdkAccounts.Account, tx *hmyStaking.StakingTransaction, chainID *big.Int) (*hmyStaking.StakingTransaction, error) {
+	if err := unlock(account); err != nil {
+		return nil, err
+	}
+
+	return sdkStaking.SignStakingTransaction(account.Keystore, account.Account, tx, chainID)
+}
+
+func unlock(account *sdkAccounts.Account) error {
+	account.Unlock()
+	if account.Keystore == nil || account.Account == nil {
+		return sdkErrors.ErrMissingAccount
+	}
+
+	return nil
+}
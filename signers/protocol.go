@@ -0,0 +1,102 @@
+package signers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	goSdkAddress "github.com/harmony-one/go-sdk/pkg/address"
+)
+
+// The remote signing protocol is a minimal JSON-RPC 2.0 protocol using a single method:
+//
+//	-> {"jsonrpc": "2.0", "id": 1, "method": "signer_signTransaction", "params": [{"address": "one1...", "type": "transaction", "chain_id": "2", "transaction": "0x<rlp encoded unsigned tx>"}]}
+//	<- {"jsonrpc": "2.0", "id": 1, "result": {"signature": "0x<65 byte R || S || V signature where V is 0 or 1>"}}
+//
+// The signature is applied locally and the recovered sender is verified against the requested address before the tx gets sent
+const (
+	// SignMethod - the JSON-RPC method used to request signatures from a remote signer
+	SignMethod = "signer_signTransaction"
+
+	// TransactionType - regular transactions
+	TransactionType = "transaction"
+
+	// EthTransactionType - eth compatible transactions
+	EthTransactionType = "eth_transaction"
+
+	// StakingTransactionType - staking transactions
+	StakingTransactionType = "staking_transaction"
+)
+
+var (
+	errMissingChainID = errors.New("the remote signer requires a chain id")
+)
+
+// SignRequest - represents a signing request sent to a remote signer
+type SignRequest struct {
+	Address     string `json:"address"`
+	Type        string `json:"type"`
+	ChainID     string `json:"chain_id"`
+	Transaction string `json:"transaction"`
+}
+
+// SignResponse - represents the response of a remote signer
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []SignRequest `json:"params"`
+}
+
+type rpcResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Result  *SignResponse `json:"result,omitempty"`
+	Error   *rpcError     `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// signatureFromValues - converts EIP155 signature values back to a 65 byte R || S || V signature
+func signatureFromValues(v *big.Int, r *big.Int, s *big.Int, chainID *big.Int) ([]byte, error) {
+	recoveryID := new(big.Int).Sub(v, new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35)))
+	if recoveryID.Sign() < 0 || recoveryID.Cmp(big.NewInt(1)) > 0 {
+		return nil, fmt.Errorf("invalid signature value v %s for chain id %s", v.String(), chainID.String())
+	}
+
+	signature := make([]byte, 65)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:64])
+	signature[64] = byte(recoveryID.Uint64())
+
+	return signature, nil
+}
+
+func decodeSignature(encoded string) ([]byte, error) {
+	signature, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature %s - error: %s", encoded, err.Error())
+	}
+
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid signature length %d, expected 65 bytes", len(signature))
+	}
+
+	return signature, nil
+}
+
+func verifySender(address string, sender goSdkAddress.T) error {
+	if goSdkAddress.Parse(address) != sender {
+		return fmt.Errorf("the remote signer returned a signature for %s instead of %s", goSdkAddress.ToBech32(sender), address)
+	}
+
+	return nil
+}
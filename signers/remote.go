@@ -0,0 +1,151 @@
+package signers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	"github.com/harmony-one/harmony/core/types"
+	hmyStaking "github.com/harmony-one/harmony/staking/types"
+)
+
+var requestID uint64
+
+// RemoteSigner - signs transactions using a remote signer speaking the JSON-RPC signing protocol, the private keys never enter the local keystore
+type RemoteSigner struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewRemoteSigner - creates a new remote signer for a given url
+func NewRemoteSigner(url string, timeout int) *RemoteSigner {
+	return &RemoteSigner{
+		URL:    url,
+		Client: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+}
+
+// SignTransaction - signs a transaction using the remote signer
+func (signer *RemoteSigner) SignTransaction(account *sdkAccounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signature, err := signer.sign(account.Address, TransactionType, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigner := types.NewEIP155Signer(chainID)
+	signedTx, err := tx.WithSignature(txSigner, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, verifySender(account.Address, sender)
+}
+
+// SignEthTransaction - signs an eth transaction using the remote signer
+func (signer *RemoteSigner) SignEthTransaction(account *sdkAccounts.Account, tx *types.EthTransaction, chainID *big.Int) (*types.EthTransaction, error) {
+	signature, err := signer.sign(account.Address, EthTransactionType, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigner := types.NewEIP155Signer(chainID)
+	signedTx, err := tx.WithSignature(txSigner, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, verifySender(account.Address, sender)
+}
+
+// SignStakingTransaction - signs a staking transaction using the remote signer
+func (signer *RemoteSigner) SignStakingTransaction(account *sdkAccounts.Account, tx *hmyStaking.StakingTransaction, chainID *big.Int) (*hmyStaking.StakingTransaction, error) {
+	signature, err := signer.sign(account.Address, StakingTransactionType, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigner := hmyStaking.NewEIP155Signer(chainID)
+	signedTx, err := tx.WithSignature(txSigner, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := hmyStaking.Sender(txSigner, signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, verifySender(account.Address, sender)
+}
+
+func (signer *RemoteSigner) sign(address string, txType string, tx interface{}, chainID *big.Int) ([]byte, error) {
+	if chainID == nil {
+		return nil, errMissingChainID
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	request := rpcRequest{
+		JSONRPC: "2.0",
+		ID:      atomic.AddUint64(&requestID, 1),
+		Method:  SignMethod,
+		Params: []SignRequest{
+			{
+				Address:     address,
+				Type:        txType,
+				ChainID:     chainID.String(),
+				Transaction: hexutil.Encode(encodedTx),
+			},
+		},
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := signer.Client.Post(signer.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the remote signer %s responded with status %s", signer.URL, response.Status)
+	}
+
+	var rpcResult rpcResponse
+	if err := json.NewDecoder(response.Body).Decode(&rpcResult); err != nil {
+		return nil, err
+	}
+
+	if rpcResult.Error != nil {
+		return nil, fmt.Errorf("the remote signer %s failed to sign the %s for %s - error: %s", signer.URL, txType, address, rpcResult.Error.Message)
+	}
+
+	if rpcResult.Result == nil {
+		return nil, fmt.Errorf("the remote signer %s didn't return a signature for %s", signer.URL, address)
+	}
+
+	return decodeSignature(rpcResult.Result.Signature)
+}
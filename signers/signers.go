@@ -0,0 +1,27 @@
+package signers
+
+import (
+	"math/big"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony/core/types"
+	hmyStaking "github.com/harmony-one/harmony/staking/types"
+)
+
+// Signer - signs transactions on behalf of a given account
+type Signer interface {
+	SignTransaction(account *sdkAccounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignEthTransaction(account *sdkAccounts.Account, tx *types.EthTransaction, chainID *big.Int) (*types.EthTransaction, error)
+	SignStakingTransaction(account *sdkAccounts.Account, tx *hmyStaking.StakingTransaction, chainID *big.Int) (*hmyStaking.StakingTransaction, error)
+}
+
+// ForAccount - returns the signer that should be used to sign transactions for a given account
+// Accounts listed in signer.addresses are signed using the remote signer when it has been enabled, all other accounts are signed using the local keystore
+func ForAccount(account *sdkAccounts.Account) Signer {
+	if config.Configuration.Signer.IsRemote(account.Address) {
+		return NewRemoteSigner(config.Configuration.Signer.URL, config.Configuration.Signer.Timeout)
+	}
+
+	return &LocalSigner{}
+}
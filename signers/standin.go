@@ -0,0 +1,109 @@
+package signers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	"github.com/harmony-one/harmony/core/types"
+	hmyStaking "github.com/harmony-one/harmony/staking/types"
+)
+
+// StandInSigner - a stand-in remote signer serving the remote signing protocol using another signer (typically the local keystore signer)
+// It's meant for verifying the remote signer setup locally and shouldn't be exposed on a public interface
+type StandInSigner struct {
+	Signer Signer
+	Lookup func(address string) (sdkAccounts.Account, error)
+}
+
+// ServeHTTP - handles signing requests
+func (standIn *StandInSigner) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var rpcRequest rpcRequest
+	if err := json.NewDecoder(request.Body).Decode(&rpcRequest); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := rpcResponse{JSONRPC: "2.0", ID: rpcRequest.ID}
+
+	if rpcRequest.Method != SignMethod || len(rpcRequest.Params) != 1 {
+		response.Error = &rpcError{Code: -32601, Message: fmt.Sprintf("unsupported method %s", rpcRequest.Method)}
+	} else if result, err := standIn.sign(rpcRequest.Params[0]); err != nil {
+		response.Error = &rpcError{Code: -32000, Message: err.Error()}
+	} else {
+		response.Result = &result
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(response)
+}
+
+func (standIn *StandInSigner) sign(signRequest SignRequest) (SignResponse, error) {
+	chainID, ok := new(big.Int).SetString(signRequest.ChainID, 10)
+	if !ok {
+		return SignResponse{}, fmt.Errorf("invalid chain id %s", signRequest.ChainID)
+	}
+
+	account, err := standIn.Lookup(signRequest.Address)
+	if err != nil {
+		return SignResponse{}, err
+	}
+
+	encodedTx, err := hexutil.Decode(signRequest.Transaction)
+	if err != nil {
+		return SignResponse{}, err
+	}
+
+	var v, r, s *big.Int
+
+	switch signRequest.Type {
+	case TransactionType:
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+			return SignResponse{}, err
+		}
+		signedTx, err := standIn.Signer.SignTransaction(&account, tx, chainID)
+		if err != nil {
+			return SignResponse{}, err
+		}
+		v, r, s = signedTx.V(), signedTx.R(), signedTx.S()
+	case EthTransactionType:
+		tx := new(types.EthTransaction)
+		if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+			return SignResponse{}, err
+		}
+		signedTx, err := standIn.Signer.SignEthTransaction(&account, tx, chainID)
+		if err != nil {
+			return SignResponse{}, err
+		}
+		v, r, s = signedTx.V(), signedTx.R(), signedTx.S()
+	case StakingTransactionType:
+		tx := new(hmyStaking.StakingTransaction)
+		if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+			return SignResponse{}, err
+		}
+		signedTx, err := standIn.Signer.SignStakingTransaction(&account, tx, chainID)
+		if err != nil {
+			return SignResponse{}, err
+		}
+		v, r, s = signedTx.RawSignatureValues()
+	default:
+		return SignResponse{}, fmt.Errorf("unsupported transaction type %s", signRequest.Type)
+	}
+
+	signature, err := signatureFromValues(v, r, s, chainID)
+	if err != nil {
+		return SignResponse{}, err
+	}
+
+	return SignResponse{Signature: hexutil.Encode(signature)}, nil
+}
@@ -5,7 +5,6 @@ import (
 
 	sdkAccounts "github.com/harmony-one/go-lib/accounts"
 	sdkNetworkNonce "github.com/harmony-one/go-lib/network/rpc/nonces"
-	sdkDelegation "github.com/harmony-one/go-lib/staking/delegation"
 	"github.com/harmony-one/harmony-tf/config"
 	testParams "github.com/harmony-one/harmony-tf/testing/parameters"
 	"github.com/harmony-one/harmony/numeric"
@@ -40,6 +39,19 @@ func executeDelegationMethod(method string, delegator *sdkAccounts.Account, vali
 
 	account.Unlock()
 
+	instruction := params.Delegation.Delegate
+	if method == "undelegate" {
+		instruction = params.Delegation.Undelegate
+	}
+
+	rpcPrefix := params.RPCPrefix
+	if instruction.RPCPrefix != "" {
+		rpcPrefix = instruction.RPCPrefix
+	}
+
+	changeRPCPrefix(rpcPrefix, params.FromShardID)
+	defer config.Configuration.Network.RevertRPCSettings()
+
 	rpcClient, err := config.Configuration.Network.API.RPCClient(params.FromShardID)
 	if err != nil {
 		return nil, err
@@ -56,37 +68,11 @@ func executeDelegationMethod(method string, delegator *sdkAccounts.Account, vali
 	}
 
 	if method == "delegate" {
-		txResult, err = sdkDelegation.Delegate(
-			account.Keystore,
-			account.Account,
-			rpcClient,
-			config.Configuration.Network.API.ChainID,
-			delegator.Address,
-			validator.Address,
-			params.Delegation.Delegate.Amount,
-			params.Delegation.Delegate.Gas.Limit,
-			params.Delegation.Delegate.Gas.Price,
-			currentNonce,
-			config.Configuration.Account.Passphrase,
-			config.Configuration.Network.API.NodeAddress(params.FromShardID),
-			params.Timeout,
-		)
+		payloadGenerator := delegatePayload(delegator.Address, validator.Address, params.Delegation.Delegate.Amount)
+		txResult, err = sendStakingTransaction(account, rpcClient, params.FromShardID, params.Delegation.Delegate.Gas.Limit, params.Delegation.Delegate.Gas.Price, currentNonce, params.Timeout, payloadGenerator)
 	} else if method == "undelegate" {
-		txResult, err = sdkDelegation.Undelegate(
-			account.Keystore,
-			account.Account,
-			rpcClient,
-			config.Configuration.Network.API.ChainID,
-			delegator.Address,
-			validator.Address,
-			params.Delegation.Undelegate.Amount,
-			params.Delegation.Undelegate.Gas.Limit,
-			params.Delegation.Undelegate.Gas.Price,
-			currentNonce,
-			config.Configuration.Account.Passphrase,
-			config.Configuration.Network.API.NodeAddress(params.FromShardID),
-			params.Timeout,
-		)
+		payloadGenerator := undelegatePayload(delegator.Address, validator.Address, params.Delegation.Undelegate.Amount)
+		txResult, err = sendStakingTransaction(account, rpcClient, params.FromShardID, params.Delegation.Undelegate.Gas.Limit, params.Delegation.Undelegate.Gas.Price, currentNonce, params.Timeout, payloadGenerator)
 	}
 
 	if err != nil {
@@ -0,0 +1,176 @@
+package staking
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkValidator "github.com/harmony-one/go-lib/staking/validator"
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/testing"
+	testParams "github.com/harmony-one/harmony-tf/testing/parameters"
+	"github.com/harmony-one/harmony/common/denominations"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// FixtureValidator - returns the validator fixture referenced by a test case, the validator is created the first time a test case uses it
+// Validators with reset enabled are re-activated if a previous test case has deactivated them
+func FixtureValidator(testCase *testing.TestCase) (*sdkAccounts.Account, *sdkValidator.Validator, error) {
+	fixture, err := testing.Fixtures.ValidatorFixture(testCase.Fixtures.Validator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if testing.ChangesValidatorState(testCase.Scenario) {
+		return nil, nil, fmt.Errorf("scenario %s changes the state of the validator it uses and can't use the shared validator fixture %s", testCase.Scenario, fixture.Name)
+	}
+
+	if testCase.StakingParameters.FromShardID != fixture.ShardID {
+		return nil, nil, fmt.Errorf("validator fixture %s lives in shard %d but the test case stakes in shard %d", fixture.Name, fixture.ShardID, testCase.StakingParameters.FromShardID)
+	}
+
+	fixture.Mutex.Lock()
+	defer fixture.Mutex.Unlock()
+
+	if fixture.Err != nil {
+		return nil, nil, fmt.Errorf("validator fixture %s couldn't be created - error: %s", fixture.Name, fixture.Err.Error())
+	}
+
+	if fixture.Validator == nil {
+		fixture.Err = createFixtureValidator(testCase, fixture)
+		if fixture.Err != nil {
+			return nil, nil, fixture.Err
+		}
+	} else if fixture.Reset {
+		resetFixtureValidator(testCase, fixture)
+	}
+
+	fixture.Uses++
+	logger.StakingLog(fmt.Sprintf("Using validator fixture %s, address: %s (use %d)", fixture.Name, fixture.Validator.Account.Address, fixture.Uses), testCase.Verbose)
+
+	return fixture.Validator.Account, fixture.Validator, nil
+}
+
+// createFixtureValidator - creates a validator fixture in the shard and through the rpc prefix declared by the fixture, only the gas settings and timeouts are taken from the test case using it first
+func createFixtureValidator(testCase *testing.TestCase, fixture *testing.ValidatorFixture) error {
+	params := testCase.StakingParameters
+	params.RPCPrefix = fixture.RPCPrefix
+	params.FromShardID = fixture.ShardID
+	params.ToShardID = fixture.ShardID
+	params.Create = fixture.Create
+	params.Mode = ""
+	params.Nonce = -1
+	params.ReuseExistingValidator = false
+
+	txParams := testCase.Parameters
+	txParams.RPCPrefix = fixture.RPCPrefix
+	txParams.FromShardID = fixture.ShardID
+	txParams.ToShardID = fixture.ShardID
+
+	fixtureCase := &testing.TestCase{
+		Name:              fixture.Name,
+		Verbose:           testCase.Verbose,
+		Expected:          true,
+		Parameters:        txParams,
+		StakingParameters: params,
+	}
+
+	logger.StakingLog(fmt.Sprintf("Creating validator fixture %s", fixture.Name), testCase.Verbose)
+
+	accountName := accounts.GenerateAccountName(fmt.Sprintf("Fixture_%s", fixture.Name))
+	account, err := testing.GenerateAndFundAccount(fixtureCase, accountName, fixture.Create.Validator.Amount, 1)
+	if err != nil {
+		return err
+	}
+	testing.Fixtures.Own(account.Address)
+
+	validator := fixtureCase.StakingParameters.Create.Validator
+	validator.Account = &account
+	fixtureCase.StakingParameters.Create.Validator.Account = &account
+
+	tx, createdBlsKeys, validatorExists, err := BasicCreateValidator(fixtureCase, &account, nil, nil)
+	if err != nil {
+		return err
+	}
+	testCase.Transactions = append(testCase.Transactions, tx)
+
+	if !validatorExists {
+		return fmt.Errorf("validator fixture %s doesn't exist after sending the create validator transaction %s", fixture.Name, tx.TransactionHash)
+	}
+
+	if config.Configuration.Network.StakingWaitTime > 0 {
+		time.Sleep(time.Duration(config.Configuration.Network.StakingWaitTime) * time.Second)
+	}
+
+	validator.Exists = validatorExists
+	validator.BLSKeys = createdBlsKeys
+	fixture.Validator = &validator
+	fixture.Parameters = fixtureCase.StakingParameters
+
+	return nil
+}
+
+// resetFixtureValidator - re-activates a validator fixture that has been deactivated by a previous test case
+func resetFixtureValidator(testCase *testing.TestCase, fixture *testing.ValidatorFixture) {
+	validatorInfo, err := ValidatorInformation(fixture.Validator.Account.Address, fixture.Parameters.FromShardID)
+	if err != nil || !strings.EqualFold(validatorInfo.Validator.EligibilityStatus, "inactive") {
+		return
+	}
+
+	logger.StakingLog(fmt.Sprintf("Re-activating validator fixture %s, address: %s", fixture.Name, fixture.Validator.Account.Address), testCase.Verbose)
+	params := fixture.Parameters
+	if _, err := EditValidatorStatus(fixture.Validator.Account, nil, &params, "active"); err != nil {
+		logger.WarningLog(fmt.Sprintf("Failed to re-activate validator fixture %s - error: %s", fixture.Name, err.Error()), testCase.Verbose)
+	}
+}
+
+// ReleaseFixtureDelegation - undelegates everything a delegator has delegated to the validator fixture used by a test case, restoring the total delegation of the fixture for the test cases using it later
+func ReleaseFixtureDelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts.Account, validatorAccount *sdkAccounts.Account) {
+	if testCase.Fixtures.Validator == "" {
+		return
+	}
+
+	node := config.Configuration.Network.API.NodeAddress(testCase.StakingParameters.FromShardID)
+	delegated, _, err := delegationAmounts(node, delegatorAccount.Address, validatorAccount.Address)
+	if err != nil {
+		logger.WarningLog(fmt.Sprintf("Failed to look up the delegation from %s to validator fixture %s - error: %s", delegatorAccount.Address, testCase.Fixtures.Validator, err.Error()), testCase.Verbose)
+		return
+	}
+
+	if delegated.Sign() <= 0 {
+		return
+	}
+
+	amount := numeric.NewDecFromBigInt(delegated).Quo(numeric.NewDec(denominations.One))
+	params := testCase.StakingParameters
+	params.Nonce = -1
+	params.Delegation.Undelegate = testParams.DelegationInstruction{
+		RPCPrefix: "hmy",
+		RawAmount: amount.String(),
+		Amount:    amount,
+		Gas:       params.Gas,
+	}
+
+	logger.StakingLog(fmt.Sprintf("Undelegating %f from validator fixture %s to restore its total delegation", params.Delegation.Undelegate.Amount, testCase.Fixtures.Validator), testCase.Verbose)
+	if _, err := Undelegate(delegatorAccount, validatorAccount, nil, &params); err != nil {
+		logger.WarningLog(fmt.Sprintf("Failed to undelegate from validator fixture %s - error: %s", testCase.Fixtures.Validator, err.Error()), testCase.Verbose)
+	}
+}
+
+// TeardownFixtures - disables the validator fixtures created during the suite, the fixture accounts are swept by the final sweep
+func TeardownFixtures() {
+	for _, fixture := range testing.Fixtures.Validators {
+		fixture.Mutex.Lock()
+		if fixture.Validator != nil {
+			logger.TeardownLog(fmt.Sprintf("Disabling validator fixture %s, address: %s", fixture.Name, fixture.Validator.Account.Address), true)
+			params := fixture.Parameters
+			if _, err := DisableValidator(fixture.Validator.Account, &params); err != nil {
+				logger.ErrorLog(fmt.Sprintf("Failed to disable validator fixture %s - error: %s", fixture.Name, err.Error()), true)
+			}
+		}
+		fixture.Mutex.Unlock()
+	}
+}
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/harmony-one/harmony-tf/balances"
@@ -18,8 +19,12 @@ import (
 	sdkTxs "github.com/harmony-one/go-lib/transactions"
 )
 
-// ReuseOrCreateValidator - reuse an existing validator or create a new one
+// ReuseOrCreateValidator - reuse a validator fixture or an existing validator or create a new one
 func ReuseOrCreateValidator(testCase *testing.TestCase, validatorName string) (account *sdkAccounts.Account, validator *sdkValidator.Validator, err error) {
+	if testCase.Fixtures.Validator != "" {
+		return FixtureValidator(testCase)
+	}
+
 	if testCase.StakingParameters.ReuseExistingValidator && config.Configuration.Framework.CurrentValidator != nil {
 		return config.Configuration.Framework.CurrentValidator.Account, config.Configuration.Framework.CurrentValidator, nil
 	}
@@ -154,6 +159,13 @@ func BasicDelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts.A
 	logger.StakingLog("Proceeding to perform delegation...", testCase.Verbose)
 	logger.TransactionLog(fmt.Sprintf("Sending delegation transaction - will wait up to %d seconds for it to finalize", testCase.StakingParameters.Timeout), testCase.Verbose)
 
+	// Delegators can be reused (e.g. from an account fixture) - compare against the amount delegated before the tx rather than looking for any delegation
+	node := config.Configuration.Network.API.NodeAddress(testCase.StakingParameters.FromShardID)
+	delegatedBefore, _, err := delegationAmounts(node, delegatorAccount.Address, validatorAccount.Address)
+	if err != nil {
+		return sdkTxs.Transaction{}, false, err
+	}
+
 	rawTx, err := Delegate(delegatorAccount, validatorAccount, senderAccount, &testCase.StakingParameters)
 	if err != nil {
 		return sdkTxs.Transaction{}, false, err
@@ -162,19 +174,12 @@ func BasicDelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts.A
 	txResultColoring := logger.ResultColoring(tx.Success, true)
 	logger.TransactionLog(fmt.Sprintf("Performed delegation - transaction hash: %s, tx successful: %s", tx.TransactionHash, txResultColoring), testCase.Verbose)
 
-	node := config.Configuration.Network.API.NodeAddress(testCase.StakingParameters.FromShardID)
-	delegations, err := sdkDelegation.ByDelegator(node, delegatorAccount.Address)
+	delegatedAfter, _, err := delegationAmounts(node, delegatorAccount.Address, validatorAccount.Address)
 	if err != nil {
 		return sdkTxs.Transaction{}, false, err
 	}
 
-	delegationSucceeded := false
-	for _, del := range delegations {
-		if del.DelegatorAddress == delegatorAccount.Address && del.ValidatorAddress == validatorAccount.Address {
-			delegationSucceeded = true
-			break
-		}
-	}
+	delegationSucceeded := delegatedAfter.Cmp(delegatedBefore) > 0
 
 	delegationSucceededColoring := logger.ResultColoring(delegationSucceeded, true)
 	logger.StakingLog(fmt.Sprintf("Delegation from %s to %s of %f, successful: %s", delegatorAccount.Address, validatorAccount.Address, testCase.StakingParameters.Delegation.Delegate.Amount, delegationSucceededColoring), testCase.Verbose)
@@ -187,6 +192,13 @@ func BasicUndelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts
 	logger.StakingLog("Proceeding to perform undelegation...", testCase.Verbose)
 	logger.TransactionLog(fmt.Sprintf("Sending undelegation transaction - will wait up to %d seconds for it to finalize", testCase.StakingParameters.Timeout), testCase.Verbose)
 
+	// Delegators can be reused (e.g. from an account fixture) - compare against the amount undelegated before the tx rather than looking for any undelegation
+	node := config.Configuration.Network.API.NodeAddress(testCase.StakingParameters.FromShardID)
+	_, undelegatedBefore, err := delegationAmounts(node, delegatorAccount.Address, validatorAccount.Address)
+	if err != nil {
+		return sdkTxs.Transaction{}, false, err
+	}
+
 	rawTx, err := Undelegate(delegatorAccount, validatorAccount, senderAccount, &testCase.StakingParameters)
 	if err != nil {
 		return sdkTxs.Transaction{}, false, err
@@ -195,19 +207,12 @@ func BasicUndelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts
 	txResultColoring := logger.ResultColoring(tx.Success, true)
 	logger.TransactionLog(fmt.Sprintf("Performed undelegation - transaction hash: %s, tx successful: %s", tx.TransactionHash, txResultColoring), testCase.Verbose)
 
-	node := config.Configuration.Network.API.NodeAddress(testCase.StakingParameters.FromShardID)
-	delegations, err := sdkDelegation.ByDelegator(node, delegatorAccount.Address)
+	_, undelegatedAfter, err := delegationAmounts(node, delegatorAccount.Address, validatorAccount.Address)
 	if err != nil {
 		return sdkTxs.Transaction{}, false, err
 	}
 
-	undelegationSucceeded := false
-	for _, del := range delegations {
-		if len(del.Undelegations) > 0 {
-			undelegationSucceeded = true
-			break
-		}
-	}
+	undelegationSucceeded := undelegatedAfter.Cmp(undelegatedBefore) > 0
 
 	undelegationSucceededColoring := logger.ResultColoring(undelegationSucceeded, true)
 	logger.StakingLog(fmt.Sprintf("Performed undelegation from validator %s by delegator %s, amount: %f, successful: %s", validatorAccount.Address, delegatorAccount.Address, testCase.StakingParameters.Delegation.Undelegate.Amount, undelegationSucceededColoring), testCase.Verbose)
@@ -215,6 +220,34 @@ func BasicUndelegation(testCase *testing.TestCase, delegatorAccount *sdkAccounts
 	return tx, true, nil
 }
 
+// delegationAmounts - the amount a delegator currently has delegated to a given validator and the amount pending undelegation, both in atto
+func delegationAmounts(node string, delegatorAddress string, validatorAddress string) (*big.Int, *big.Int, error) {
+	delegated, undelegated := big.NewInt(0), big.NewInt(0)
+
+	delegations, err := sdkDelegation.ByDelegator(node, delegatorAddress)
+	if err != nil {
+		return delegated, undelegated, err
+	}
+
+	for _, del := range delegations {
+		if del.DelegatorAddress != delegatorAddress || del.ValidatorAddress != validatorAddress {
+			continue
+		}
+
+		if del.RawAmount != nil {
+			delegated.Add(delegated, del.RawAmount)
+		}
+
+		for _, undel := range del.Undelegations {
+			if undel.RawAmount != nil {
+				undelegated.Add(undelegated, undel.RawAmount)
+			}
+		}
+	}
+
+	return delegated, undelegated, nil
+}
+
 // ManageBLSKeys - manage bls keys for edit validator scenarios
 func ManageBLSKeys(validator *sdkValidator.Validator, mode string, blsSignatureMessage string, verbose bool) (blsKeyToRemove *sdkCrypto.BLSKey, blsKeyToAdd *sdkCrypto.BLSKey, err error) {
 	switch mode {
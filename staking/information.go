@@ -0,0 +1,59 @@
+package staking
+
+import (
+	"fmt"
+
+	sdkDelegation "github.com/harmony-one/go-lib/staking/delegation"
+	sdkValidator "github.com/harmony-one/go-lib/staking/validator"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony/numeric"
+	harmonyTypes "github.com/harmony-one/harmony/staking/types"
+)
+
+var (
+	// DelegationUnit - the smallest amount that can be delegated (1 atto)
+	DelegationUnit = numeric.NewDecWithPrec(1, 18)
+
+	// NetworkMinimumSelfDelegation - the minimum self delegation the network accepts when creating a validator
+	NetworkMinimumSelfDelegation = numeric.NewDec(harmonyTypes.TenThousand)
+)
+
+// ValidatorInformation - retrieves the live validator information for a given validator address
+func ValidatorInformation(validatorAddress string, shardID uint32) (sdkValidator.RPCValidatorResult, error) {
+	node := config.Configuration.Network.API.NodeAddress(shardID)
+	return sdkValidator.Information(node, validatorAddress)
+}
+
+// DelegationsByDelegator - retrieves the live delegations (including undelegations and rewards) of a given delegator address
+func DelegationsByDelegator(delegatorAddress string) ([]sdkDelegation.DelegationInfo, error) {
+	delegations, err := sdkDelegation.ByDelegator(config.Configuration.Network.API.NodeAddress(0), delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkDelegation.InitializeDelegationInfos(delegations)
+}
+
+// DelegationsByValidator - retrieves the live delegations (including undelegations and rewards) to a given validator address
+func DelegationsByValidator(validatorAddress string) ([]sdkDelegation.DelegationInfo, error) {
+	delegations, err := sdkDelegation.ByValidator(config.Configuration.Network.API.NodeAddress(0), validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkDelegation.InitializeDelegationInfos(delegations)
+}
+
+// RemainingDelegationCapacity - calculates how much can still be delegated to a validator before it reaches its maximum total delegation
+func RemainingDelegationCapacity(validatorAddress string, shardID uint32) (numeric.Dec, sdkValidator.RPCValidatorResult, error) {
+	validatorInfo, err := ValidatorInformation(validatorAddress, shardID)
+	if err != nil {
+		return numeric.NewDec(0), validatorInfo, err
+	}
+
+	if validatorInfo.Validator.MaxTotalDelegation.IsNil() || validatorInfo.TotalDelegation.IsNil() {
+		return numeric.NewDec(0), validatorInfo, fmt.Errorf("failed to retrieve the maximum total delegation and total delegation for validator %s", validatorAddress)
+	}
+
+	return validatorInfo.Validator.MaxTotalDelegation.Sub(validatorInfo.TotalDelegation), validatorInfo, nil
+}
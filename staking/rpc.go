@@ -0,0 +1,20 @@
+package staking
+
+import (
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/rpc"
+	testParams "github.com/harmony-one/harmony-tf/testing/parameters"
+)
+
+// changeRPCSettings - switches over to the eth_ RPC namespace and the corresponding Ethereum chain id when the staking parameters use the eth rpc prefix
+func changeRPCSettings(params *testParams.StakingParameters) {
+	changeRPCPrefix(params.RPCPrefix, params.FromShardID)
+}
+
+// changeRPCPrefix - switches over to the eth_ RPC namespace and the corresponding Ethereum chain id when the given rpc prefix is eth
+func changeRPCPrefix(rpcPrefix string, shardID uint32) {
+	if rpcPrefix == "eth" {
+		ethChainID := rpc.GenerateEthereumChainID(config.Configuration.Network.Name, shardID)
+		config.Configuration.Network.ChangeRPCSettings(rpcPrefix, ethChainID)
+	}
+}
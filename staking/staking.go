@@ -33,6 +33,9 @@ func CreateValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAc
 		params.Create.Validator.Account = validatorAccount
 	}
 
+	changeRPCSettings(params)
+	defer config.Configuration.Network.RevertRPCSettings()
+
 	rpcClient, err := config.Configuration.Network.API.RPCClient(params.FromShardID)
 	if err != nil {
 		return nil, err
@@ -48,11 +51,7 @@ func CreateValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAc
 		currentNonce = uint64(params.Nonce)
 	}
 
-	txResult, err := sdkValidator.Create(
-		senderAccount.Keystore,
-		senderAccount.Account,
-		rpcClient,
-		config.Configuration.Network.API.ChainID,
+	payloadGenerator := createValidatorPayload(
 		validatorAccount.Address,
 		params.Create.Validator.ToStakingDescription(),
 		params.Create.Validator.ToCommissionRates(),
@@ -60,18 +59,16 @@ func CreateValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAc
 		params.Create.Validator.MaximumTotalDelegation,
 		blsKeys,
 		params.Create.Validator.Amount,
-		params.Gas.Limit,
-		params.Gas.Price,
-		currentNonce,
-		config.Configuration.Account.Passphrase,
-		config.Configuration.Network.API.NodeAddress(params.FromShardID),
-		params.Timeout,
 	)
 
+	txResult, err := sendStakingTransaction(senderAccount, rpcClient, params.FromShardID, params.Gas.Limit, params.Gas.Price, currentNonce, params.Timeout, payloadGenerator)
+
 	if err != nil {
 		return nil, err
 	}
 
+	trackValidator(validatorAccount.Address)
+
 	return txResult, nil
 }
 
@@ -86,6 +83,9 @@ func EditValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAcco
 		params.Edit.Validator.Account = validatorAccount
 	}
 
+	changeRPCSettings(params)
+	defer config.Configuration.Network.RevertRPCSettings()
+
 	rpcClient, err := config.Configuration.Network.API.RPCClient(params.FromShardID)
 	if err != nil {
 		return nil, err
@@ -114,11 +114,7 @@ func EditValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAcco
 		gasPrice = params.Edit.Gas.Price
 	}
 
-	txResult, err := sdkValidator.Edit(
-		senderAccount.Keystore,
-		senderAccount.Account,
-		rpcClient,
-		config.Configuration.Network.API.ChainID,
+	payloadGenerator := editValidatorPayload(
 		validatorAccount.Address,
 		params.Edit.Validator.ToStakingDescription(),
 		commissionRate,
@@ -127,14 +123,10 @@ func EditValidator(validatorAccount *sdkAccounts.Account, senderAccount *sdkAcco
 		blsKeyToRemove,
 		blsKeyToAdd,
 		params.Edit.Validator.EligibilityStatus,
-		gasLimit,
-		gasPrice,
-		currentNonce,
-		config.Configuration.Account.Passphrase,
-		config.Configuration.Network.API.NodeAddress(params.FromShardID),
-		params.Timeout,
 	)
 
+	txResult, err := sendStakingTransaction(senderAccount, rpcClient, params.FromShardID, gasLimit, gasPrice, currentNonce, params.Timeout, payloadGenerator)
+
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +150,9 @@ func EditValidatorStatus(validatorAccount *sdkAccounts.Account, senderAccount *s
 		params.Edit.Validator.Account = validatorAccount
 	}
 
+	changeRPCSettings(params)
+	defer config.Configuration.Network.RevertRPCSettings()
+
 	rpcClient, err := config.Configuration.Network.API.RPCClient(params.FromShardID)
 	if err != nil {
 		return nil, err
@@ -181,20 +176,9 @@ func EditValidatorStatus(validatorAccount *sdkAccounts.Account, senderAccount *s
 		gasPrice = params.Edit.Gas.Price
 	}
 
-	txResult, err := sdkValidator.EditStatus(
-		senderAccount.Keystore,
-		senderAccount.Account,
-		rpcClient,
-		config.Configuration.Network.API.ChainID,
-		validatorAccount.Address,
-		status,
-		gasLimit,
-		gasPrice,
-		currentNonce,
-		config.Configuration.Account.Passphrase,
-		config.Configuration.Network.API.NodeAddress(params.FromShardID),
-		params.Timeout,
-	)
+	payloadGenerator := editValidatorStatusPayload(validatorAccount.Address, status)
+
+	txResult, err := sendStakingTransaction(senderAccount, rpcClient, params.FromShardID, gasLimit, gasPrice, currentNonce, params.Timeout, payloadGenerator)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,32 @@
+package staking
+
+import (
+	"sync"
+)
+
+var (
+	validatorsMutex   sync.Mutex
+	createdValidators []string
+)
+
+// trackValidator - keeps track of a validator created by the run
+func trackValidator(address string) {
+	validatorsMutex.Lock()
+	defer validatorsMutex.Unlock()
+
+	for _, existing := range createdValidators {
+		if existing == address {
+			return
+		}
+	}
+
+	createdValidators = append(createdValidators, address)
+}
+
+// CreatedValidators - the addresses of the validators created by the run, in the order they were created
+func CreatedValidators() []string {
+	validatorsMutex.Lock()
+	defer validatorsMutex.Unlock()
+
+	return append([]string{}, createdValidators...)
+}
@@ -0,0 +1,148 @@
+package staking
+
+import (
+	"strings"
+	"time"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkCrypto "github.com/harmony-one/go-lib/crypto"
+	sdkStaking "github.com/harmony-one/go-lib/staking"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/go-sdk/pkg/address"
+	"github.com/harmony-one/go-sdk/pkg/rpc"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/signers"
+	"github.com/harmony-one/harmony-tf/transactions"
+	"github.com/harmony-one/harmony/crypto/bls"
+	"github.com/harmony-one/harmony/numeric"
+	"github.com/harmony-one/harmony/staking/effective"
+	hmyStaking "github.com/harmony-one/harmony/staking/types"
+)
+
+// sendStakingTransaction - generates a staking tx using the supplied payload generator, signs it using the signer of the sender account and sends it
+func sendStakingTransaction(senderAccount *sdkAccounts.Account, rpcClient *rpc.HTTPMessenger, shardID uint32, gasLimit int64, gasPrice numeric.Dec, nonce uint64, timeout int, payloadGenerator hmyStaking.StakeMsgFulfiller) (map[string]interface{}, error) {
+	stakingTx, calculatedGasLimit, err := sdkStaking.GenerateStakingTransaction(gasLimit, gasPrice, nonce, payloadGenerator)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := signers.ForAccount(senderAccount).SignStakingTransaction(senderAccount, stakingTx, config.Configuration.Network.API.ChainID.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := sdkTxs.EncodeSignature(signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions.TrackTransaction(transactions.NewRawTransaction("staking", *signature, signedTx.Hash().Hex(), senderAccount.Address, "", nonce, shardID, shardID, config.Configuration.Network.API.ChainID, numeric.Dec{}, int64(calculatedGasLimit), gasPrice, ""))
+
+	submittedAt := time.Now().UTC()
+	receiptHash, err := sdkStaking.SendRawStakingTransaction(rpcClient, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if hash, ok := receiptHash.(string); ok {
+		transactions.TrackSubmission(hash, submittedAt, time.Time{})
+
+		if timeout > 0 {
+			result, _ := sdkTxs.WaitForTxConfirmation(rpcClient, config.Configuration.Network.API.NodeAddress(shardID), "staking", hash, timeout)
+			if result != nil {
+				transactions.TrackSubmission(hash, submittedAt, time.Now().UTC())
+				return result, nil
+			}
+		}
+	}
+
+	result := make(map[string]interface{})
+	result["transactionHash"] = receiptHash
+
+	return result, nil
+}
+
+func createValidatorPayload(validatorAddress string, description hmyStaking.Description, commissionRates hmyStaking.CommissionRates, minimumSelfDelegation numeric.Dec, maximumTotalDelegation numeric.Dec, blsKeys []sdkCrypto.BLSKey, amount numeric.Dec) hmyStaking.StakeMsgFulfiller {
+	blsPubKeys, blsSigs := sdkStaking.ProcessBlsKeys(blsKeys)
+
+	return func() (hmyStaking.Directive, interface{}) {
+		return hmyStaking.DirectiveCreateValidator, hmyStaking.CreateValidator{
+			ValidatorAddress:   address.Parse(validatorAddress),
+			Description:        description,
+			CommissionRates:    commissionRates,
+			MinSelfDelegation:  sdkStaking.NumericDecToBigIntAmount(minimumSelfDelegation),
+			MaxTotalDelegation: sdkStaking.NumericDecToBigIntAmount(maximumTotalDelegation),
+			SlotPubKeys:        blsPubKeys,
+			SlotKeySigs:        blsSigs,
+			Amount:             sdkStaking.NumericDecToBigIntAmount(amount),
+		}
+	}
+}
+
+func editValidatorPayload(validatorAddress string, description hmyStaking.Description, commissionRate *numeric.Dec, minimumSelfDelegation numeric.Dec, maximumTotalDelegation numeric.Dec, blsKeyToRemove *sdkCrypto.BLSKey, blsKeyToAdd *sdkCrypto.BLSKey, status string) hmyStaking.StakeMsgFulfiller {
+	var shardBlsKeyToRemove *bls.SerializedPublicKey
+	if blsKeyToRemove != nil {
+		shardBlsKeyToRemove = blsKeyToRemove.ShardPublicKey
+	}
+
+	var shardBlsKeyToAdd *bls.SerializedPublicKey
+	var shardBlsKeyToAddSig *bls.SerializedSignature
+	if blsKeyToAdd != nil {
+		shardBlsKeyToAdd = blsKeyToAdd.ShardPublicKey
+		shardBlsKeyToAddSig = blsKeyToAdd.ShardSignature
+	}
+
+	return func() (hmyStaking.Directive, interface{}) {
+		return hmyStaking.DirectiveEditValidator, hmyStaking.EditValidator{
+			ValidatorAddress:   address.Parse(validatorAddress),
+			Description:        description,
+			CommissionRate:     commissionRate,
+			MinSelfDelegation:  sdkStaking.NumericDecToBigIntAmount(minimumSelfDelegation),
+			MaxTotalDelegation: sdkStaking.NumericDecToBigIntAmount(maximumTotalDelegation),
+			SlotKeyToRemove:    shardBlsKeyToRemove,
+			SlotKeyToAdd:       shardBlsKeyToAdd,
+			SlotKeyToAddSig:    shardBlsKeyToAddSig,
+			EPOSStatus:         eposStatus(status),
+		}
+	}
+}
+
+func editValidatorStatusPayload(validatorAddress string, status string) hmyStaking.StakeMsgFulfiller {
+	return func() (hmyStaking.Directive, interface{}) {
+		return hmyStaking.DirectiveEditValidator, hmyStaking.EditValidator{
+			ValidatorAddress: address.Parse(validatorAddress),
+			EPOSStatus:       eposStatus(status),
+		}
+	}
+}
+
+func delegatePayload(delegatorAddress string, validatorAddress string, amount numeric.Dec) hmyStaking.StakeMsgFulfiller {
+	return func() (hmyStaking.Directive, interface{}) {
+		return hmyStaking.DirectiveDelegate, hmyStaking.Delegate{
+			DelegatorAddress: address.Parse(delegatorAddress),
+			ValidatorAddress: address.Parse(validatorAddress),
+			Amount:           sdkStaking.NumericDecToBigIntAmount(amount),
+		}
+	}
+}
+
+func undelegatePayload(delegatorAddress string, validatorAddress string, amount numeric.Dec) hmyStaking.StakeMsgFulfiller {
+	return func() (hmyStaking.Directive, interface{}) {
+		return hmyStaking.DirectiveUndelegate, hmyStaking.Undelegate{
+			DelegatorAddress: address.Parse(delegatorAddress),
+			ValidatorAddress: address.Parse(validatorAddress),
+			Amount:           sdkStaking.NumericDecToBigIntAmount(amount),
+		}
+	}
+}
+
+func eposStatus(status string) effective.Eligibility {
+	switch strings.ToLower(status) {
+	case "active":
+		return effective.Active
+	case "inactive":
+		return effective.Inactive
+	default:
+		return effective.Nil
+	}
+}
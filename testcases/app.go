@@ -9,13 +9,12 @@ import (
 	"github.com/harmony-one/harmony-tf/config"
 	"github.com/harmony-one/harmony-tf/export"
 	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/history"
+	"github.com/harmony-one/harmony-tf/invariants"
 	"github.com/harmony-one/harmony-tf/keys"
-	stakingDelegationDelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/delegate"
-	stakingDelegationRedelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/redelegate"
-	stakingDelegationUndelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/undelegate"
-	stakingCreateValidatorScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/validator/create"
-	stakingEditValidatorScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/validator/edit"
-	transactionScenarios "github.com/harmony-one/harmony-tf/scenarios/transactions"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/notifications"
+	"github.com/harmony-one/harmony-tf/staking"
 	"github.com/harmony-one/harmony-tf/testing"
 )
 
@@ -34,16 +33,22 @@ var (
 )
 
 // Execute - executes all registered/identified test cases
+// The returned error carries the exit code (see config.ExitCode) - test failures take precedence over dismissed test cases
 func Execute() error {
 	header()
 
 	if err := prepare(); err != nil {
-		return err
+		return Abort(err)
+	}
+
+	if funding.FundingPool != nil {
+		defer funding.FundingPool.Stop()
 	}
 
 	if len(TestCases) > 0 {
 		execute()
 		successfulCount, failedCount, duration := results()
+		invariants.PrintSummary()
 
 		switch strings.ToLower(config.Configuration.Export.Format) {
 		case "csv":
@@ -53,16 +58,62 @@ func Execute() error {
 			} else if csvPath != "" {
 				fmt.Printf("Successfully exported test case results to %s\n", csvPath)
 			}
+		case "html":
+			htmlPath, err := export.ExportHTML(Results, Dismissed, successfulCount, failedCount, duration)
+			if err != nil {
+				fmt.Printf("Failed to export test case results to HTML - error: %s\n", err.Error())
+			} else if htmlPath != "" {
+				fmt.Printf("Successfully exported the test case report to %s\n", htmlPath)
+			}
 		//case "json":
 		default:
 		}
 
+		if runID, err := history.Record(Results, Dismissed, duration); err != nil {
+			fmt.Printf("Failed to record the run history - error: %s\n", err.Error())
+		} else if runID != "" {
+			fmt.Printf("Recorded the results as run %s - use the history command to compare runs\n", runID)
+		}
+
+		if txsPath, err := export.ExportTransactions(Results); err != nil {
+			fmt.Printf("Failed to export the test case transactions - error: %s\n", err.Error())
+		} else if txsPath != "" {
+			fmt.Printf("Successfully exported the test case transactions to %s\n", txsPath)
+		}
+
+		if summaryPath, err := export.ExportSummary(Results, Dismissed, successfulCount, failedCount, duration); err != nil {
+			fmt.Printf("Failed to write the summary file - error: %s\n", err.Error())
+		} else if summaryPath != "" {
+			fmt.Printf("Successfully wrote the run summary to %s\n", summaryPath)
+		}
+
+		notify(notifications.Completed(Results, Dismissed, successfulCount, failedCount, duration))
+
 		footer()
-	} else {
-		fmt.Println(fmt.Sprintf("Couldn't find any test cases - are you sure you've placed them in the testcases folder?"))
+
+		staking.TeardownFixtures()
+
+		logger.TeardownLog("Performing the final teardown (sweeping all generated accounts back to the funding account)", true)
+		report := testing.Teardowns.SweepAll()
+		report.Print()
+
+		return outcome(failedCount)
 	}
 
-	return nil
+	fmt.Println(fmt.Sprintf("Couldn't find any test cases - are you sure you've placed them in the testcases folder?"))
+
+	return Abort(config.NewExitError(config.ExitConfigurationError, fmt.Errorf("couldn't find any test cases")))
+}
+
+// Abort - writes the run summary for a run that has been aborted before executing its test cases (e.g. due to a configuration or funding error) and returns the error
+func Abort(err error) error {
+	if summaryPath, summaryErr := export.ExportAbortedSummary(err); summaryErr != nil {
+		fmt.Printf("Failed to write the summary file - error: %s\n", summaryErr.Error())
+	} else if summaryPath != "" {
+		fmt.Printf("Wrote the summary of the aborted run to %s\n", summaryPath)
+	}
+
+	return err
 }
 
 func header() {
@@ -76,6 +127,10 @@ func header() {
 			strings.Repeat("\t", 15),
 		),
 	)
+
+	if config.Configuration.Framework.Seed != "" {
+		fmt.Println(fmt.Sprintf("Deriving the generated accounts from the configured seed using run %s - use the same seed and --run %s to reproduce this run", config.Configuration.Framework.Run, config.Configuration.Framework.Run))
+	}
 }
 
 func load() error {
@@ -84,6 +139,10 @@ func load() error {
 		return err
 	}
 
+	if err := testing.LoadFixtures(); err != nil {
+		return config.NewExitError(config.ExitConfigurationError, err)
+	}
+
 	return nil
 }
 
@@ -98,7 +157,11 @@ func prepare() (err error) {
 	}
 
 	if err = funding.SetupFundingAccount(accs); err != nil {
-		return err
+		return config.NewExitError(config.ExitFundingError, err)
+	}
+
+	if err = funding.SetupFundingPool(); err != nil {
+		return config.NewExitError(config.ExitFundingError, err)
 	}
 
 	return nil
@@ -107,46 +170,13 @@ func prepare() (err error) {
 func execute() {
 	for _, testCase := range TestCases {
 		if testCase.Execute {
-			switch testCase.Scenario {
-			case "transactions/standard":
-				transactionScenarios.StandardScenario(testCase)
-			case "transactions/same_account":
-				transactionScenarios.SameAccountScenario(testCase)
-			case "transactions/multiple_senders":
-				transactionScenarios.MultipleSenderScenario(testCase)
-			case "transactions/multiple_receivers_invalid_nonce":
-				transactionScenarios.MultipleReceiverInvalidNonceScenario(testCase)
-			case "staking/validator/create/standard":
-				stakingCreateValidatorScenarios.StandardScenario(testCase)
-			case "staking/validator/create/invalid_address":
-				stakingCreateValidatorScenarios.InvalidAddressScenario(testCase)
-			case "staking/validator/create/already_exists":
-				stakingCreateValidatorScenarios.AlreadyExistsScenario(testCase)
-			case "staking/validator/create/existing_bls_key":
-				stakingCreateValidatorScenarios.ExistingBLSKeyScenario(testCase)
-			case "staking/validator/edit/standard":
-				stakingEditValidatorScenarios.StandardScenario(testCase)
-			case "staking/validator/edit/invalid_address":
-				stakingEditValidatorScenarios.InvalidAddressScenario(testCase)
-			case "staking/validator/edit/non_existing":
-				stakingEditValidatorScenarios.NonExistingScenario(testCase)
-			case "staking/delegation/delegate/standard":
-				stakingDelegationDelegateScenarios.StandardScenario(testCase)
-			case "staking/delegation/delegate/invalid_address":
-				stakingDelegationDelegateScenarios.InvalidAddressScenario(testCase)
-			case "staking/delegation/delegate/non_existing":
-				stakingDelegationDelegateScenarios.NonExistingScenario(testCase)
-			case "staking/delegation/undelegate/standard":
-				stakingDelegationUndelegateScenarios.StandardScenario(testCase)
-			case "staking/delegation/undelegate/invalid_address":
-				stakingDelegationUndelegateScenarios.InvalidAddressScenario(testCase)
-			case "staking/delegation/undelegate/non_existing":
-				stakingDelegationUndelegateScenarios.NonExistingScenario(testCase)
-			case "staking/delegation/redelegate/standard":
-				stakingDelegationRedelegateScenarios.StandardScenario(testCase)
-			case "staking/delegation/redelegate/locked_tokens":
-				stakingDelegationRedelegateScenarios.NextEpochScenario(testCase)
-			default:
+			if scenario, ok := Scenarios[testCase.Scenario]; ok {
+				snapshot := invariants.Start(testCase)
+				scenario(testCase)
+				if testCase.Executed {
+					snapshot.Check(testCase)
+				}
+			} else {
 				testCase.Executed = false
 				fmt.Println(fmt.Sprintf("Please specify a valid test type for your test case %s", testCase.Name))
 			}
@@ -155,6 +185,9 @@ func execute() {
 				Results = append(Results, testCase)
 				if !testCase.Successful() {
 					Failed = append(Failed, testCase)
+					if len(Failed) == 1 {
+						notify(notifications.FirstFailure(testCase, len(Results)))
+					}
 				}
 			} else {
 				Dismissed = append(Dismissed, testCase)
@@ -165,6 +198,25 @@ func execute() {
 	}
 }
 
+// outcome - the test suite outcome as an error carrying the exit code, nil if all executed test cases passed
+func outcome(failedCount int) error {
+	if failedCount > 0 {
+		return config.NewExitError(config.ExitTestFailures, fmt.Errorf("%d test case(s) failed", failedCount))
+	}
+
+	if len(Dismissed) > 0 {
+		return config.NewExitError(config.ExitDismissedOnly, fmt.Errorf("%d test case(s) were dismissed", len(Dismissed)))
+	}
+
+	return nil
+}
+
+func notify(notification notifications.Notification) {
+	if err := notifications.Notify(notification); err != nil {
+		fmt.Printf("Failed to send the %s notification - error: %s\n", notification.Event, err.Error())
+	}
+}
+
 func results() (successfulCount int, failedCount int, duration time.Duration) {
 	config.Configuration.Framework.EndTime = time.Now().UTC()
 	duration = config.Configuration.Framework.EndTime.Sub(config.Configuration.Framework.StartTime)
@@ -12,6 +12,7 @@ import (
 	"github.com/harmony-one/harmony-tf/config"
 	"github.com/harmony-one/harmony-tf/testing"
 	"github.com/harmony-one/harmony-tf/utils"
+	yaml "gopkg.in/yaml.v2"
 )
 
 func loadTestCases() error {
@@ -22,23 +23,50 @@ func loadTestCases() error {
 
 	for el := mapping.Front(); el != nil; el = el.Next() {
 		for _, testCaseFile := range el.Value.([]string) {
-			testCase := &testing.TestCase{}
-			err := utils.ParseYaml(testCaseFile, testCase)
+			testCases, err := parseTestCaseFile(testCaseFile)
 
 			if err == nil {
-				testCase.Initialize()
-				TestCases = append(TestCases, testCase)
+				TestCases = append(TestCases, testCases...)
 			} else {
-				fmt.Printf("Failed to parse test case file: %s - error: %s. Please make sure the test case file is valid YAML!\n", testCaseFile, err.Error())
+				fmt.Printf("Failed to parse test case file: %s - error: %s. Please make sure the test case file is valid YAML - the validate command reports the exact location of the issue\n", testCaseFile, err.Error())
 			}
 		}
 	}
 
-	fmt.Println(fmt.Sprintf("Found a total of %d test case files", len(TestCases)))
+	fmt.Println(fmt.Sprintf("Found a total of %d test cases", len(TestCases)))
 
 	return nil
 }
 
+// parseTestCaseFile - parses a test case file, files including a matrix section are expanded into one test case per combination of the matrix values
+func parseTestCaseFile(testCaseFile string) (testCases []*testing.TestCase, err error) {
+	data, err := utils.ReadFileToString(testCaseFile)
+	if err != nil {
+		return nil, err
+	}
+
+	expansions, err := testing.ExpandMatrix([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, expansion := range expansions {
+		testCase := &testing.TestCase{}
+		if err := yaml.Unmarshal(expansion.Data, testCase); err != nil {
+			return nil, err
+		}
+
+		testCase.Initialize()
+		testCases = append(testCases, testCase)
+	}
+
+	if len(expansions) > 1 {
+		fmt.Println(fmt.Sprintf("Expanded the matrix of test case file %s into %d test cases", testCaseFile, len(expansions)))
+	}
+
+	return testCases, nil
+}
+
 func identifyTestCaseFiles(ext string) (*orderedmap.OrderedMap, error) {
 	files := []string{}
 
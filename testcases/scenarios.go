@@ -0,0 +1,53 @@
+package testcases
+
+import (
+	stakingDelegationDelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/delegate"
+	stakingDelegationRedelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/redelegate"
+	stakingDelegationUndelegateScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/delegation/undelegate"
+	stakingCreateValidatorScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/validator/create"
+	stakingEditValidatorScenarios "github.com/harmony-one/harmony-tf/scenarios/staking/validator/edit"
+	transactionScenarios "github.com/harmony-one/harmony-tf/scenarios/transactions"
+	transactionGasScenarios "github.com/harmony-one/harmony-tf/scenarios/transactions/gas"
+	transactionReplayScenarios "github.com/harmony-one/harmony-tf/scenarios/transactions/replay"
+	"github.com/harmony-one/harmony-tf/testing"
+)
+
+var (
+	// Scenarios - maps the scenario names used in test case files to the functions executing them
+	Scenarios = map[string]func(testCase *testing.TestCase){
+		"transactions/standard":                                  transactionScenarios.StandardScenario,
+		"transactions/same_account":                              transactionScenarios.SameAccountScenario,
+		"transactions/multiple_senders":                          transactionScenarios.MultipleSenderScenario,
+		"transactions/multiple_receivers_invalid_nonce":          transactionScenarios.MultipleReceiverInvalidNonceScenario,
+		"transactions/gas/zero_price":                            transactionGasScenarios.ZeroPriceScenario,
+		"transactions/gas/below_intrinsic_gas":                   transactionGasScenarios.BelowIntrinsicGasScenario,
+		"transactions/gas/exceeds_block_gas_limit":               transactionGasScenarios.ExceedsBlockGasLimitScenario,
+		"transactions/gas/replacement_higher_price":              transactionGasScenarios.HigherPriceReplacementScenario,
+		"transactions/gas/replacement_lower_price":               transactionGasScenarios.LowerPriceReplacementScenario,
+		"transactions/gas/replacement_same_price":                transactionGasScenarios.SamePriceReplacementScenario,
+		"transactions/replay/wrong_network_chain_id":             transactionReplayScenarios.WrongNetworkChainIDScenario,
+		"transactions/replay/wrong_shard_offset_chain_id":        transactionReplayScenarios.WrongShardOffsetChainIDScenario,
+		"transactions/replay/same_shard":                         transactionReplayScenarios.SameShardScenario,
+		"transactions/replay/cross_shard":                        transactionReplayScenarios.CrossShardScenario,
+		"staking/validator/create/standard":                      stakingCreateValidatorScenarios.StandardScenario,
+		"staking/validator/create/invalid_address":               stakingCreateValidatorScenarios.InvalidAddressScenario,
+		"staking/validator/create/already_exists":                stakingCreateValidatorScenarios.AlreadyExistsScenario,
+		"staking/validator/create/existing_bls_key":              stakingCreateValidatorScenarios.ExistingBLSKeyScenario,
+		"staking/validator/create/below_minimum_self_delegation": stakingCreateValidatorScenarios.BelowMinimumSelfDelegationScenario,
+		"staking/validator/create/non_beacon_shard":              stakingCreateValidatorScenarios.NonBeaconShardScenario,
+		"staking/validator/edit/standard":                        stakingEditValidatorScenarios.StandardScenario,
+		"staking/validator/edit/invalid_address":                 stakingEditValidatorScenarios.InvalidAddressScenario,
+		"staking/validator/edit/non_existing":                    stakingEditValidatorScenarios.NonExistingScenario,
+		"staking/validator/edit/lower_maximum_total_delegation":  stakingEditValidatorScenarios.LowerMaximumTotalDelegationScenario,
+		"staking/delegation/delegate/standard":                   stakingDelegationDelegateScenarios.StandardScenario,
+		"staking/delegation/delegate/invalid_address":            stakingDelegationDelegateScenarios.InvalidAddressScenario,
+		"staking/delegation/delegate/non_existing":               stakingDelegationDelegateScenarios.NonExistingScenario,
+		"staking/delegation/delegate/maximum_total_delegation":   stakingDelegationDelegateScenarios.MaximumTotalDelegationScenario,
+		"staking/delegation/delegate/non_beacon_shard":           stakingDelegationDelegateScenarios.NonBeaconShardScenario,
+		"staking/delegation/undelegate/standard":                 stakingDelegationUndelegateScenarios.StandardScenario,
+		"staking/delegation/undelegate/invalid_address":          stakingDelegationUndelegateScenarios.InvalidAddressScenario,
+		"staking/delegation/undelegate/non_existing":             stakingDelegationUndelegateScenarios.NonExistingScenario,
+		"staking/delegation/redelegate/standard":                 stakingDelegationRedelegateScenarios.StandardScenario,
+		"staking/delegation/redelegate/locked_tokens":            stakingDelegationRedelegateScenarios.NextEpochScenario,
+	}
+)
@@ -0,0 +1,289 @@
+package testing
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkValidator "github.com/harmony-one/go-lib/staking/validator"
+	"github.com/harmony-one/harmony-tf/accounts"
+	"github.com/harmony-one/harmony-tf/balances"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/funding"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/testing/parameters"
+	"github.com/harmony-one/harmony-tf/utils"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+var (
+	// Fixtures - the fixtures shared by the test cases of the suite
+	Fixtures = &FixtureRegistry{}
+
+	// StatefulValidatorScenarios - scenarios changing the capacity, details or delegations of the validator they use, they can't use validator fixtures since later uses would inherit their changes
+	StatefulValidatorScenarios = []string{
+		"staking/validator/edit/",
+		"staking/delegation/delegate/maximum_total_delegation",
+		"staking/delegation/undelegate/",
+		"staking/delegation/redelegate/",
+	}
+)
+
+// FixtureReferences - the fixtures a test case uses
+type FixtureReferences struct {
+	Validator  string `yaml:"validator"`
+	Delegators string `yaml:"delegators"`
+}
+
+// FixtureRegistry - the fixtures declared in fixtures.yml, fixtures are created lazily the first time a test case uses them and torn down at the end of the suite
+type FixtureRegistry struct {
+	Validators []*ValidatorFixture `yaml:"validators"`
+	Accounts   []*AccountFixture   `yaml:"accounts"`
+
+	mutex sync.Mutex
+	owned map[string]bool
+}
+
+// ValidatorFixture - a validator shared by the test cases referencing it
+type ValidatorFixture struct {
+	Name      string                               `yaml:"name"`
+	ShardID   uint32                               `yaml:"shard_id"`
+	RPCPrefix string                               `yaml:"rpc_prefix"`
+	Create    parameters.CreateValidatorParameters `yaml:"create"`
+	// Reset - re-activates the validator before it's used again if a previous test case has deactivated it
+	Reset bool `yaml:"reset"`
+
+	Mutex      sync.Mutex                   `yaml:"-"`
+	Validator  *sdkValidator.Validator      `yaml:"-"`
+	Parameters parameters.StakingParameters `yaml:"-"`
+	Uses       int                          `yaml:"-"`
+	Err        error                        `yaml:"-"`
+}
+
+// AccountFixture - a pool of accounts shared by the test cases referencing it, every use gets the least recently used account of the pool
+type AccountFixture struct {
+	Name  string `yaml:"name"`
+	Count int    `yaml:"count"`
+
+	mutex    sync.Mutex
+	accounts []sdkAccounts.Account
+	next     int
+}
+
+// FixturesPath - the path of the suite level fixtures file
+func FixturesPath() string {
+	return filepath.Join(config.Configuration.Framework.BasePath, "fixtures.yml")
+}
+
+// LoadFixtures - loads and initializes the fixtures declared in fixtures.yml, the file is optional
+func LoadFixtures() error {
+	registry := &FixtureRegistry{}
+
+	if _, err := os.Stat(FixturesPath()); err == nil {
+		if err := utils.ParseYaml(FixturesPath(), registry); err != nil {
+			return fmt.Errorf("failed to parse the fixtures file %s - error: %s", FixturesPath(), err.Error())
+		}
+	}
+
+	if err := registry.Initialize(); err != nil {
+		return err
+	}
+
+	Fixtures = registry
+
+	if count := len(registry.Validators) + len(registry.Accounts); count > 0 {
+		fmt.Println(fmt.Sprintf("Found a total of %d fixtures", count))
+	}
+
+	return nil
+}
+
+// Initialize - validates the fixture declarations and converts their values
+func (registry *FixtureRegistry) Initialize() error {
+	registry.owned = make(map[string]bool)
+	names := make(map[string]bool)
+
+	for _, fixture := range registry.Validators {
+		if err := registry.checkName(fixture.Name, names); err != nil {
+			return err
+		}
+
+		if fixture.RPCPrefix == "" {
+			fixture.RPCPrefix = "hmy"
+		}
+
+		if fixture.RPCPrefix != "hmy" && fixture.RPCPrefix != "eth" {
+			return fmt.Errorf("fixture %s: unknown rpc prefix %s - valid prefixes: hmy, eth", fixture.Name, fixture.RPCPrefix)
+		}
+
+		// Mirrors the staking parameters - fixtures targeting shards that aren't available on localnet use the highest available shard instead
+		if shards := config.Configuration.Network.Shards; shards > 0 && fixture.ShardID > uint32(shards-1) {
+			fixture.ShardID = uint32(shards - 1)
+		}
+
+		if fixture.Create.Validator.RawAmount == "" {
+			return fmt.Errorf("fixture %s: the validator amount (self delegation) is required", fixture.Name)
+		}
+
+		if err := fixture.Create.Initialize(); err != nil {
+			return fmt.Errorf("fixture %s: %s", fixture.Name, err.Error())
+		}
+	}
+
+	for _, fixture := range registry.Accounts {
+		if err := registry.checkName(fixture.Name, names); err != nil {
+			return err
+		}
+
+		if fixture.Count <= 0 {
+			fixture.Count = 1
+		}
+	}
+
+	return nil
+}
+
+func (registry *FixtureRegistry) checkName(name string, names map[string]bool) error {
+	if name == "" {
+		return fmt.Errorf("every fixture requires a name")
+	}
+
+	if names[name] {
+		return fmt.Errorf("fixture %s has been declared more than once", name)
+	}
+	names[name] = true
+
+	return nil
+}
+
+// ValidatorFixture - looks up a validator fixture by name
+func (registry *FixtureRegistry) ValidatorFixture(name string) (*ValidatorFixture, error) {
+	for _, fixture := range registry.Validators {
+		if fixture.Name == name {
+			return fixture, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown validator fixture %s - make sure it has been declared in %s", name, FixturesPath())
+}
+
+// AccountFixture - looks up an account fixture by name
+func (registry *FixtureRegistry) AccountFixture(name string) (*AccountFixture, error) {
+	for _, fixture := range registry.Accounts {
+		if fixture.Name == name {
+			return fixture, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown account fixture %s - make sure it has been declared in %s", name, FixturesPath())
+}
+
+// ChangesValidatorState - whether or not a scenario changes the state of the validator it uses (and therefore can't use a validator fixture)
+func ChangesValidatorState(scenario string) bool {
+	scenario = strings.ToLower(scenario)
+
+	for _, prefix := range StatefulValidatorScenarios {
+		if strings.HasPrefix(scenario, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Own - marks an account as belonging to a fixture so that it's only torn down at the end of the suite
+func (registry *FixtureRegistry) Own(address string) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if registry.owned == nil {
+		registry.owned = make(map[string]bool)
+	}
+	registry.owned[address] = true
+}
+
+// Owns - whether or not an account belongs to a fixture
+func (registry *FixtureRegistry) Owns(address string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	return registry.owned[address]
+}
+
+// AcquireOrGenerateAccount - acquires an account from the account fixture referenced by the test case (topping it up to the required amount) or generates and funds a new account
+func AcquireOrGenerateAccount(testCase *TestCase, fixtureName string, accountName string, amount numeric.Dec, fundingMultiple int64) (sdkAccounts.Account, error) {
+	if fixtureName == "" {
+		return GenerateAndFundAccount(testCase, accountName, amount, fundingMultiple)
+	}
+
+	fixture, err := Fixtures.AccountFixture(fixtureName)
+	if err != nil {
+		return sdkAccounts.Account{}, err
+	}
+
+	return fixture.Acquire(testCase, amount, fundingMultiple)
+}
+
+// Acquire - returns the least recently used account of the pool, accounts are generated lazily until the pool is full
+// The account is topped up to the required amount in case a previous test case has spent its funds
+func (fixture *AccountFixture) Acquire(testCase *TestCase, amount numeric.Dec, fundingMultiple int64) (sdkAccounts.Account, error) {
+	fixture.mutex.Lock()
+	defer fixture.mutex.Unlock()
+
+	if len(fixture.accounts) < fixture.Count {
+		accountName := accounts.GenerateAccountName(fmt.Sprintf("Fixture_%s_%d", fixture.Name, len(fixture.accounts)))
+		account, err := GenerateAndFundAccount(testCase, accountName, amount, fundingMultiple)
+		if err != nil {
+			return account, err
+		}
+
+		Fixtures.Own(account.Address)
+		fixture.accounts = append(fixture.accounts, account)
+		logger.AccountLog(fmt.Sprintf("Created account %d/%d of fixture %s: %s, address: %s", len(fixture.accounts), fixture.Count, fixture.Name, account.Name, account.Address), testCase.Verbose)
+
+		return account, nil
+	}
+
+	account := fixture.accounts[fixture.next%len(fixture.accounts)]
+	fixture.next++
+	logger.AccountLog(fmt.Sprintf("Reusing account %s, address: %s of fixture %s", account.Name, account.Address, fixture.Name), testCase.Verbose)
+
+	err := resetAccount(testCase, &account, amount, fundingMultiple)
+
+	return account, err
+}
+
+// resetAccount - tops up a reused fixture account to the amount a test case requires
+func resetAccount(testCase *TestCase, account *sdkAccounts.Account, amount numeric.Dec, fundingMultiple int64) error {
+	fundingAmount, err := funding.CalculateFundingAmount(amount, fundingMultiple)
+	if err != nil {
+		return err
+	}
+
+	balance, err := balances.GetShardBalance(account.Address, testCase.StakingParameters.FromShardID)
+	if err != nil {
+		return err
+	}
+
+	if balance.IsNil() {
+		return fmt.Errorf("Can't fetch the balance for account %s, address: %s in shard %d", account.Name, account.Address, testCase.StakingParameters.FromShardID)
+	}
+
+	if balance.LT(fundingAmount) {
+		if err := funding.FundAccount(testCase.Parameters.FromShardID, account.Address, testCase.StakingParameters.FromShardID, fundingAmount.Sub(balance)); err != nil {
+			return err
+		}
+
+		if balance, err = balances.GetExpectedShardBalance(account.Address, testCase.StakingParameters.FromShardID, fundingAmount); err != nil {
+			return err
+		}
+	}
+
+	account.Balance = balance
+	logger.BalanceLog(fmt.Sprintf("Account %s, address: %s has a starting balance of %f in shard %d before the test", account.Name, account.Address, balance, testCase.StakingParameters.FromShardID), testCase.Verbose)
+
+	return nil
+}
@@ -38,21 +38,8 @@ func GenerateAndFundAccount(testCase *TestCase, accountName string, amount numer
 		return sdkAccounts.Account{}, fmt.Errorf("Can't fetch starting balance for account %s, address: %s in shard %d", account.Name, account.Address, testCase.StakingParameters.FromShardID)
 	}
 
-	gasLimit := -1
-
 	if accountStartingBalance.LT(fundingAmount) {
-		funding.PerformFundingTransaction(
-			&config.Configuration.Funding.Account,
-			testCase.Parameters.FromShardID,
-			account.Address,
-			testCase.Parameters.ToShardID,
-			fundingAmount,
-			gasLimit,
-			config.Configuration.Funding.Gas.Limit,
-			config.Configuration.Funding.Gas.Price,
-			config.Configuration.Funding.Timeout,
-			config.Configuration.Funding.Retry.Attempts,
-		)
+		funding.FundAccount(testCase.Parameters.FromShardID, account.Address, testCase.StakingParameters.FromShardID, fundingAmount)
 		accountStartingBalance, err = balances.GetShardBalance(account.Address, testCase.StakingParameters.FromShardID)
 		if err != nil {
 			return sdkAccounts.Account{}, err
@@ -65,3 +52,30 @@ func GenerateAndFundAccount(testCase *TestCase, accountName string, amount numer
 
 	return account, nil
 }
+
+// GenerateSenderAndReceiver - generates and funds a sender account covering txCount transactions of the test case amount and generates an unfunded receiver account
+func GenerateSenderAndReceiver(testCase *TestCase, txCount int64) (senderAccount sdkAccounts.Account, receiverAccount sdkAccounts.Account, err error) {
+	_, requiredFunding, err := funding.CalculateFundingDetails(testCase.Parameters.Amount, txCount, testCase.Parameters.FromShardID)
+	if err != nil {
+		return senderAccount, receiverAccount, err
+	}
+
+	senderAccountName := accounts.GenerateTestCaseAccountName(testCase.Name, "Sender")
+	logger.AccountLog(fmt.Sprintf("Generating a new sender account: %s", senderAccountName), testCase.Verbose)
+	senderAccount, err = accounts.GenerateAccount(senderAccountName)
+	if err != nil {
+		return senderAccount, receiverAccount, err
+	}
+
+	logger.FundingLog(fmt.Sprintf("Funding sender account: %s, address: %s", senderAccount.Name, senderAccount.Address), testCase.Verbose)
+	funding.FundAccount(testCase.Parameters.FromShardID, senderAccount.Address, testCase.Parameters.FromShardID, requiredFunding)
+
+	receiverAccountName := accounts.GenerateTestCaseAccountName(testCase.Name, "Receiver")
+	logger.AccountLog(fmt.Sprintf("Generating a new receiver account: %s", receiverAccountName), testCase.Verbose)
+	receiverAccount, err = accounts.GenerateAccount(receiverAccountName)
+	if err != nil {
+		return senderAccount, receiverAccount, err
+	}
+
+	return senderAccount, receiverAccount, nil
+}
@@ -0,0 +1,211 @@
+package testing
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	matrixNameRegex = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+	// matrixSections - the sections keys without a dot can refer to, in order of precedence
+	matrixSections = []string{"parameters", "staking_parameters"}
+)
+
+// MatrixExpansion - a test case generated from a matrix, Paths maps the matrix keys to the document paths they've been applied to
+type MatrixExpansion struct {
+	Name  string
+	Data  []byte
+	Paths map[string]string
+}
+
+// ExpandMatrix - expands the matrix section of a test case document into one document per combination (the cartesian product of the listed values).
+// Keys without a dot refer to the parameters or staking_parameters section used by the document (e.g. from_shard_id), other paths can be targeted using dotted paths (e.g. staking_parameters.delegation.delegate.amount).
+// Documents without a matrix are returned as is.
+func ExpandMatrix(data []byte) ([]MatrixExpansion, error) {
+	document := yaml.MapSlice{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	matrix, ok := mapSliceValue(document, "matrix")
+	if !ok {
+		return []MatrixExpansion{{Data: data}}, nil
+	}
+
+	entries, ok := matrix.(yaml.MapSlice)
+	if !ok || len(entries) == 0 {
+		return nil, fmt.Errorf("matrix: expected a mapping of keys to lists of values")
+	}
+
+	name := ""
+	if rawName, ok := mapSliceValue(document, "name"); ok {
+		name = fmt.Sprintf("%v", rawName)
+	}
+
+	keys := []string{}
+	paths := make(map[string]string)
+	values := [][]interface{}{}
+	for _, entry := range entries {
+		key := fmt.Sprintf("%v", entry.Key)
+		list, ok := entry.Value.([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, fmt.Errorf("matrix.%s: expected a non-empty list of values", key)
+		}
+
+		path, err := matrixPath(document, key)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+		paths[key] = path
+		values = append(values, list)
+	}
+
+	base := removeMapSliceKey(document, "matrix")
+	expansions := []MatrixExpansion{}
+	names := make(map[string]bool)
+
+	for _, combination := range cartesianProduct(values) {
+		expanded := base
+		suffix := []string{}
+
+		for index, key := range keys {
+			expanded = setMapSlicePath(expanded, strings.Split(paths[key], "."), combination[index])
+			suffix = append(suffix, matrixNamePart(key, combination[index]))
+		}
+
+		expandedName := fmt.Sprintf("%s_%s", name, strings.Join(suffix, "_"))
+		if names[expandedName] {
+			expandedName = fmt.Sprintf("%s_%d", expandedName, len(expansions))
+		}
+		names[expandedName] = true
+		expanded = setMapSlicePath(expanded, []string{"name"}, expandedName)
+
+		expandedData, err := yaml.Marshal(expanded)
+		if err != nil {
+			return nil, err
+		}
+
+		expansions = append(expansions, MatrixExpansion{Name: expandedName, Data: expandedData, Paths: paths})
+	}
+
+	return expansions, nil
+}
+
+// matrixPath - the document path a matrix key applies to, keys without a dot are resolved against the parameter section the document uses
+func matrixPath(document yaml.MapSlice, key string) (string, error) {
+	if strings.Contains(key, ".") {
+		return key, nil
+	}
+
+	sections := []string{}
+	for _, section := range matrixSections {
+		if _, ok := mapSliceValue(document, section); ok {
+			sections = append(sections, section)
+		}
+	}
+
+	// Documents using both sections are only unambiguous if exactly one of them already contains the key
+	if len(sections) > 1 {
+		containing := []string{}
+		for _, section := range sections {
+			value, _ := mapSliceValue(document, section)
+			if child, ok := value.(yaml.MapSlice); ok {
+				if _, ok := mapSliceValue(child, key); ok {
+					containing = append(containing, section)
+				}
+			}
+		}
+
+		if len(containing) != 1 {
+			return "", fmt.Errorf("matrix.%s: the key is ambiguous since the test case uses both %s - use a dotted path instead (e.g. %s.%s)", key, strings.Join(sections, " and "), sections[0], key)
+		}
+		sections = containing
+	}
+
+	if len(sections) == 0 {
+		sections = matrixSections
+	}
+
+	return fmt.Sprintf("%s.%s", sections[0], key), nil
+}
+
+// matrixNamePart - the name suffix of a matrix value, e.g. from_shard_id_1 or rpc_prefix_eth
+func matrixNamePart(key string, value interface{}) string {
+	if index := strings.LastIndex(key, "."); index >= 0 {
+		key = key[index+1:]
+	}
+
+	return strings.Trim(matrixNameRegex.ReplaceAllString(fmt.Sprintf("%s_%v", key, value), "_"), "_")
+}
+
+// cartesianProduct - all combinations of the given value lists, the last list varies the fastest
+func cartesianProduct(values [][]interface{}) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, list := range values {
+		next := [][]interface{}{}
+		for _, combination := range combinations {
+			for _, value := range list {
+				expanded := append(append([]interface{}{}, combination...), value)
+				next = append(next, expanded)
+			}
+		}
+		combinations = next
+	}
+
+	return combinations
+}
+
+func mapSliceValue(slice yaml.MapSlice, key string) (interface{}, bool) {
+	for _, item := range slice {
+		if fmt.Sprintf("%v", item.Key) == key {
+			return item.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func removeMapSliceKey(slice yaml.MapSlice, key string) yaml.MapSlice {
+	removed := yaml.MapSlice{}
+
+	for _, item := range slice {
+		if fmt.Sprintf("%v", item.Key) != key {
+			removed = append(removed, item)
+		}
+	}
+
+	return removed
+}
+
+// setMapSlicePath - returns a copy of the given document with the value set at the given path, missing sections are created
+func setMapSlicePath(slice yaml.MapSlice, path []string, value interface{}) yaml.MapSlice {
+	updated := append(yaml.MapSlice{}, slice...)
+
+	for index, item := range updated {
+		if fmt.Sprintf("%v", item.Key) != path[0] {
+			continue
+		}
+
+		if len(path) == 1 {
+			updated[index].Value = value
+		} else {
+			child, _ := item.Value.(yaml.MapSlice)
+			updated[index].Value = setMapSlicePath(child, path[1:], value)
+		}
+
+		return updated
+	}
+
+	if len(path) == 1 {
+		return append(updated, yaml.MapItem{Key: path[0], Value: value})
+	}
+
+	return append(updated, yaml.MapItem{Key: path[0], Value: setMapSlicePath(yaml.MapSlice{}, path[1:], value)})
+}
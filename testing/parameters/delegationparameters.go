@@ -19,6 +19,8 @@ type DelegationParameters struct {
 
 // DelegationInstruction - represents a delegation or undelegation instruction
 type DelegationInstruction struct {
+	// Overrides the rpc prefix of the staking parameters for this instruction only (e.g. to delegate over eth_ to a validator created over hmy_)
+	RPCPrefix string              `yaml:"rpc_prefix,omitempty"`
 	RawAmount string              `yaml:"amount"`
 	Amount    numeric.Dec         `yaml:"-"`
 	Gas       sdkNetworkTypes.Gas `yaml:"gas"`
@@ -4,11 +4,13 @@ import (
 	"strings"
 
 	sdkNetworkTypes "github.com/harmony-one/go-lib/network/types/network"
+	"github.com/harmony-one/harmony-tf/config"
 )
 
 // StakingParameters - represents the test case staking tx parameters
 type StakingParameters struct {
-	FromShardID uint32 `yaml:"-"`
+	RPCPrefix   string `yaml:"rpc_prefix,omitempty"`
+	FromShardID uint32 `yaml:"from_shard_id"`
 	ToShardID   uint32 `yaml:"-"`
 	Count       int    `yaml:"count"`
 
@@ -26,8 +28,16 @@ type StakingParameters struct {
 
 // Initialize - initializes and converts values for a given test case
 func (params *StakingParameters) Initialize() (err error) {
-	params.FromShardID = uint32(0)
-	params.ToShardID = uint32(0)
+	if params.RPCPrefix == "" {
+		params.RPCPrefix = "hmy"
+	}
+
+	// Staking txs are only accepted on the beacon chain (shard 0) - non-beacon shards can only be targeted explicitly (i.e. by negative test cases)
+	// Switch test cases targeting shards that aren't available on localnet to use the highest available shard on localnet (typically 1)
+	if params.FromShardID > uint32(config.Configuration.Network.Shards-1) {
+		params.FromShardID = uint32(config.Configuration.Network.Shards - 1)
+	}
+	params.ToShardID = params.FromShardID
 
 	if len(params.Mode) > 0 {
 		params.Mode = strings.ToLower(params.Mode)
@@ -1,35 +1,292 @@
 package testing
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
 	"sync"
+	"time"
 
 	sdkAccounts "github.com/harmony-one/go-lib/accounts"
 	goSdkAccount "github.com/harmony-one/go-sdk/pkg/account"
+	"github.com/harmony-one/harmony-tf/accounts"
 	"github.com/harmony-one/harmony-tf/balances"
 	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
 	"github.com/harmony-one/harmony-tf/transactions"
 	"github.com/harmony-one/harmony/numeric"
 )
 
-// Teardown - return any sent tokens (minus a gas cost) and remove the account from the keystore
-func Teardown(account *sdkAccounts.Account, fromShardID uint32, toAddress string, toShardID uint32) {
-	amount, err := balances.GetShardBalance(account.Address, fromShardID)
+var (
+	// Teardowns - collects the outcome of every sweep performed during the run
+	Teardowns = NewTeardownManager()
+)
 
-	if err == nil && !amount.IsNil() {
-		if amount.GT(numeric.NewDec(0)) {
-			amount = amount.Sub(config.Configuration.Funding.Gas.Cost)
-		}
+// TeardownManager - sweeps the funds of generated accounts back to the funding account and keeps track of the outcome
+type TeardownManager struct {
+	mutex     sync.Mutex
+	recovered numeric.Dec
+	gas       numeric.Dec
+	sweeps    int
+	failures  int
+}
 
-		if amount.GT(numeric.NewDec(0)) {
-			transactions.SendTransaction(account, fromShardID, toAddress, toShardID, amount, -1, config.Configuration.Funding.Gas.Limit, config.Configuration.Funding.Gas.Price, "", 0)
-		}
+// TeardownReport - the outcome of the final sweep
+type TeardownReport struct {
+	Accounts  int
+	Sweeps    int
+	Failures  int
+	Recovered numeric.Dec
+	Gas       numeric.Dec
+	Dust      numeric.Dec
+	Remaining []RemainingFunds
+}
+
+// RemainingFunds - funds that couldn't be recovered from an account in a given shard
+type RemainingFunds struct {
+	Name    string
+	Address string
+	ShardID uint32
+	Balance numeric.Dec
+}
+
+type sweepJob struct {
+	account sdkAccounts.Account
+	shardID uint32
+}
+
+type sweepResult struct {
+	sweepJob
+	remaining numeric.Dec
+}
+
+// NewTeardownManager - creates a new teardown manager
+func NewTeardownManager() *TeardownManager {
+	return &TeardownManager{
+		recovered: numeric.NewDec(0),
+		gas:       numeric.NewDec(0),
+	}
+}
+
+// Teardown - return any sent tokens (minus a gas cost) to a given address
+// The account is kept in the keystore until the final sweep has verified that it doesn't hold any funds in any shard
+// Fixture accounts are skipped since they're shared by other test cases - they're swept by the final sweep instead
+func Teardown(account *sdkAccounts.Account, fromShardID uint32, toAddress string, toShardID uint32) error {
+	if Fixtures.Owns(account.Address) {
+		return nil
 	}
 
-	goSdkAccount.RemoveAccount(account.Name)
+	_, err := Teardowns.Sweep(account, fromShardID, toAddress, toShardID)
+	return err
 }
 
-// AsyncTeardown - return any sent tokens (minus a gas cost) and remove the account from the keystore
+// AsyncTeardown - return any sent tokens (minus a gas cost) and calls Done() on the waitGroup
 func AsyncTeardown(account *sdkAccounts.Account, fromShardID uint32, toAddress string, toShardID uint32, waitGroup *sync.WaitGroup) {
 	defer waitGroup.Done()
 	Teardown(account, fromShardID, toAddress, toShardID)
 }
+
+// TeardownSenderAndReceiver - returns the funds of a sender and receiver account pair generated using GenerateSenderAndReceiver to the funding account
+func TeardownSenderAndReceiver(testCase *TestCase, senderAccount sdkAccounts.Account, receiverAccount sdkAccounts.Account) {
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(2)
+
+	go AsyncTeardown(&senderAccount, testCase.Parameters.FromShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
+	go AsyncTeardown(&receiverAccount, testCase.Parameters.ToShardID, config.Configuration.Funding.Account.Address, testCase.Parameters.FromShardID, &waitGroup)
+
+	waitGroup.Wait()
+}
+
+// Sweep - sends the balance of an account in a given shard (minus a gas cost) to a given address, retrying failed attempts
+// Returns the balance remaining in the shard after the sweep
+func (manager *TeardownManager) Sweep(account *sdkAccounts.Account, fromShardID uint32, toAddress string, toShardID uint32) (remaining numeric.Dec, err error) {
+	gasCost := config.Configuration.Funding.Gas.Cost
+	attempts := config.Configuration.Teardown.Retry.Attempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		balance, balanceErr := balances.GetShardBalance(account.Address, fromShardID)
+		if balanceErr != nil || balance.IsNil() {
+			err = fmt.Errorf("failed to retrieve the balance of %s in shard %d", account.Address, fromShardID)
+			if balanceErr != nil {
+				err = fmt.Errorf("%s - error: %s", err.Error(), balanceErr.Error())
+			}
+			manager.wait(attempt, attempts)
+			continue
+		}
+
+		if !balance.GT(gasCost) {
+			return balance, nil
+		}
+
+		amount := balance.Sub(gasCost)
+		receipt, sendErr := transactions.SendTransaction(account, fromShardID, toAddress, toShardID, amount, -1, config.Configuration.Funding.Gas.Limit, config.Configuration.Funding.Gas.Price, "", config.Configuration.Funding.Timeout)
+		if sendErr == nil && !transactions.Succeeded(receipt) {
+			sendErr = fmt.Errorf("the sweep transaction %v wasn't confirmed within %d seconds", receipt["transactionHash"], config.Configuration.Funding.Timeout)
+		}
+
+		if sendErr != nil {
+			err = sendErr
+			logger.TeardownLog(fmt.Sprintf("Failed to sweep %f from %s in shard %d (attempt %d/%d) - error: %s", amount, account.Address, fromShardID, attempt, attempts, err.Error()), config.Configuration.Funding.Verbose)
+			manager.wait(attempt, attempts)
+			continue
+		}
+
+		// Only the amount confirmed by the balance change is recorded as recovered
+		remaining, balanceErr = balances.GetShardBalance(account.Address, fromShardID)
+		if balanceErr != nil || remaining.IsNil() {
+			err = fmt.Errorf("failed to verify the sweep of %s in shard %d - the balance couldn't be retrieved", account.Address, fromShardID)
+			manager.wait(attempt, attempts)
+			continue
+		}
+
+		gas := sweepGas(receipt, balance.Sub(remaining).Sub(amount))
+		recovered := balance.Sub(remaining).Sub(gas)
+		if recovered.IsNegative() {
+			recovered = numeric.NewDec(0)
+		}
+
+		manager.record(recovered, gas)
+
+		return remaining, nil
+	}
+
+	manager.mutex.Lock()
+	manager.failures++
+	manager.mutex.Unlock()
+
+	return numeric.NewDec(-1), err
+}
+
+// SweepAll - sweeps every shard of every generated account back to the funding account using bounded concurrency
+// Accounts that no longer hold any recoverable funds are removed from the keystore
+func (manager *TeardownManager) SweepAll() TeardownReport {
+	accs := accounts.Tracked()
+	shards := uint32(config.Configuration.Network.Shards)
+	gasCost := config.Configuration.Funding.Gas.Cost
+
+	jobs := make(chan sweepJob)
+	results := make(chan sweepResult)
+
+	concurrency := config.Configuration.Teardown.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < concurrency; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for job := range jobs {
+				remaining, err := manager.Sweep(&job.account, job.shardID, config.Configuration.Funding.Account.Address, job.shardID)
+				if err != nil {
+					logger.ErrorLog(fmt.Sprintf("Failed to sweep %s in shard %d - error: %s", job.account.Address, job.shardID, err.Error()), true)
+				}
+				results <- sweepResult{sweepJob: job, remaining: remaining}
+			}
+		}()
+	}
+
+	go func() {
+		for _, account := range accs {
+			for shardID := uint32(0); shardID < shards; shardID++ {
+				jobs <- sweepJob{account: account, shardID: shardID}
+			}
+		}
+		close(jobs)
+		waitGroup.Wait()
+		close(results)
+	}()
+
+	report := TeardownReport{Accounts: len(accs), Dust: numeric.NewDec(0)}
+	holdsFunds := make(map[string]bool)
+
+	for result := range results {
+		switch {
+		case result.remaining.IsNegative():
+			holdsFunds[result.account.Address] = true
+			report.Remaining = append(report.Remaining, RemainingFunds{Name: result.account.Name, Address: result.account.Address, ShardID: result.shardID})
+		case result.remaining.GT(gasCost):
+			holdsFunds[result.account.Address] = true
+			report.Remaining = append(report.Remaining, RemainingFunds{Name: result.account.Name, Address: result.account.Address, ShardID: result.shardID, Balance: result.remaining})
+		default:
+			report.Dust = report.Dust.Add(result.remaining)
+		}
+	}
+
+	for _, account := range accs {
+		if !holdsFunds[account.Address] {
+			goSdkAccount.RemoveAccount(account.Name)
+			accounts.Untrack(account.Address)
+		}
+	}
+
+	manager.mutex.Lock()
+	report.Sweeps = manager.sweeps
+	report.Failures = manager.failures
+	report.Recovered = manager.recovered
+	report.Gas = manager.gas
+	manager.mutex.Unlock()
+
+	return report
+}
+
+// Print - outputs the teardown report
+func (report *TeardownReport) Print() {
+	logger.TeardownLog(fmt.Sprintf("Swept %d generated accounts - %d successful sweeps, %d failed sweeps", report.Accounts, report.Sweeps, report.Failures), true)
+	logger.TeardownLog(fmt.Sprintf("Recovered: %f, spent on gas: %f, unrecoverable dust: %f", report.Recovered, report.Gas, report.Dust), true)
+
+	if len(report.Remaining) == 0 {
+		return
+	}
+
+	logger.WarningLog(fmt.Sprintf("%d account shards still hold funds - the accounts have been kept in the keystore:", len(report.Remaining)), true)
+	for _, remaining := range report.Remaining {
+		if remaining.Balance.IsNil() {
+			logger.WarningLog(fmt.Sprintf("\t%s (%s) - shard %d: unknown balance", remaining.Address, remaining.Name, remaining.ShardID), true)
+		} else {
+			logger.WarningLog(fmt.Sprintf("\t%s (%s) - shard %d: %f", remaining.Address, remaining.Name, remaining.ShardID, remaining.Balance), true)
+		}
+	}
+}
+
+// sweepGas - the gas fee of a confirmed sweep using the gas used reported by its receipt, falls back to the fee implied by the balance change
+func sweepGas(receipt map[string]interface{}, implied numeric.Dec) numeric.Dec {
+	var gasUsed *big.Int
+	switch value := receipt["gasUsed"].(type) {
+	case string:
+		if strings.HasPrefix(value, "0x") {
+			gasUsed, _ = new(big.Int).SetString(value[2:], 16)
+		} else {
+			gasUsed, _ = new(big.Int).SetString(value, 10)
+		}
+	case float64:
+		gasUsed = big.NewInt(int64(value))
+	}
+
+	if gasUsed == nil {
+		return implied
+	}
+
+	return numeric.NewDecFromBigInt(gasUsed).Mul(config.Configuration.Funding.Gas.Price).Quo(numeric.NewDec(1000000000))
+}
+
+func (manager *TeardownManager) record(recovered numeric.Dec, gas numeric.Dec) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	manager.sweeps++
+	manager.recovered = manager.recovered.Add(recovered)
+	if gas.IsPositive() {
+		manager.gas = manager.gas.Add(gas)
+	}
+}
+
+func (manager *TeardownManager) wait(attempt int, attempts int) {
+	if attempt < attempts && config.Configuration.Teardown.Retry.Wait > 0 {
+		time.Sleep(time.Duration(config.Configuration.Teardown.Retry.Wait) * time.Second)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/harmony-one/harmony-tf/config"
 	"github.com/harmony-one/harmony-tf/logger"
 	"github.com/harmony-one/harmony-tf/testing/parameters"
+	"github.com/harmony-one/harmony-tf/transactions"
+	yaml "gopkg.in/yaml.v2"
 )
 
 // TestCase - represents a test case
@@ -27,9 +29,12 @@ type TestCase struct {
 	Verbose           bool      `yaml:"verbose"`
 	Scenario          string    `yaml:"scenario"`
 	Dismissal         string    `yaml:"-"`
+	Rejections        []string  `yaml:"-"`
 	Error             error
 	Parameters        parameters.Parameters        `yaml:"parameters"`
 	StakingParameters parameters.StakingParameters `yaml:"staking_parameters"`
+	Matrix            yaml.MapSlice                `yaml:"matrix,omitempty"`
+	Fixtures          FixtureReferences            `yaml:"fixtures"`
 	Transactions      []sdkTxs.Transaction
 	SuccessfulTxCount int64 `yaml:"-"`
 	Function          interface{}
@@ -55,6 +60,11 @@ func (testCase *TestCase) Initialize() {
 		}
 	}
 
+	// Validator fixtures are shared by several test cases - they're never disabled or torn down by the test cases themselves
+	if testCase.Fixtures.Validator != "" {
+		testCase.StakingParameters.ReuseExistingValidator = true
+	}
+
 	if config.Configuration.Network.Timeout > 0 {
 		testCase.Parameters.Timeout = config.Configuration.Network.Timeout
 		testCase.StakingParameters.Timeout = config.Configuration.Network.Timeout
@@ -84,6 +94,19 @@ func (testCase *TestCase) ReportMemoryDismissal() {
 	Title(testCase, "footer", testCase.Verbose)
 }
 
+// ReportShardDismissal - reports a dismissal for test cases that require a non-beacon shard on networks that only have a beacon shard
+func (testCase *TestCase) ReportShardDismissal() {
+	msg := fmt.Sprintf(
+		"Skipping test case %s since it requires a non-beacon shard and the network %s only has %d shard(s)",
+		testCase.Name,
+		config.Configuration.Network.Name,
+		config.Configuration.Network.Shards,
+	)
+	testCase.Dismissal = fmt.Sprintf("Test case requires a non-beacon shard, total shards available on the network: %d", config.Configuration.Network.Shards)
+	logger.WarningLog(msg, testCase.Verbose)
+	Title(testCase, "footer", testCase.Verbose)
+}
+
 // Successful - if the test case result matches the expected result
 func (testCase *TestCase) Successful() bool {
 	return testCase.Result == testCase.Expected
@@ -125,6 +148,49 @@ func statusMessage(status bool) string {
 	return "FAILURE"
 }
 
+// RecordRejection - records the reason a node gave for rejecting a test case tx
+func (testCase *TestCase) RecordRejection(description string, err error) {
+	if err != nil {
+		rejection := fmt.Sprintf("%s: %s", description, err.Error())
+		testCase.Rejections = append(testCase.Rejections, rejection)
+		logger.TransactionLog(fmt.Sprintf("Rejected - %s", rejection), testCase.Verbose)
+	}
+}
+
+// ExpectRejection - checks that a tx was rejected using one of the expected errors and records the rejection reason
+func (testCase *TestCase) ExpectRejection(description string, err error, expectedErrs ...error) bool {
+	expectedMessages := []string{}
+	for _, expectedErr := range expectedErrs {
+		expectedMessages = append(expectedMessages, expectedErr.Error())
+	}
+	expectedMessage := strings.Join(expectedMessages, " or ")
+
+	if err == nil {
+		logger.ErrorLog(fmt.Sprintf("%s was accepted by the network - expected it to be rejected with the error: %s", description, expectedMessage), testCase.Verbose)
+		return false
+	}
+
+	testCase.RecordRejection(description, err)
+
+	rejected := false
+	for _, expectedErr := range expectedErrs {
+		if transactions.IsError(err, expectedErr) {
+			rejected = true
+			break
+		}
+	}
+
+	rejectedColoring := logger.ResultColoring(rejected, true)
+	logger.TransactionLog(fmt.Sprintf("%s was rejected using the expected error (%s): %s", description, expectedMessage, rejectedColoring), testCase.Verbose)
+
+	return rejected
+}
+
+// RejectionMessage - returns all of the recorded rejection reasons for the test case
+func (testCase *TestCase) RejectionMessage() string {
+	return strings.Join(testCase.Rejections, "; ")
+}
+
 // SetErrorState - set the error state for a test case based on a given error
 func (testCase *TestCase) SetErrorState() {
 	testCase.Result = false
@@ -0,0 +1,35 @@
+package transactions
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+// BlockGasLimit - retrieves the gas limit of the latest block in a given shard, transactions using a higher gas limit are rejected by the tx pool
+func BlockGasLimit(shardID uint32) (uint64, error) {
+	rpcClient, err := config.Configuration.Network.API.RPCClient(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := rpcClient.SendRPC("hmy_getBlockByNumber", []interface{}{"latest", false})
+	if err != nil {
+		return 0, err
+	}
+
+	block, ok := reply["result"].(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("failed to retrieve the latest block in shard %d", shardID)
+	}
+
+	switch gasLimit := block["gasLimit"].(type) {
+	case string:
+		return hexutil.DecodeUint64(gasLimit)
+	case float64:
+		return uint64(gasLimit), nil
+	default:
+		return 0, fmt.Errorf("failed to retrieve the block gas limit in shard %d", shardID)
+	}
+}
@@ -0,0 +1,87 @@
+package transactions
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/go-sdk/pkg/address"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/signers"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// SignContractTransaction - generates and signs a same shard transaction carrying raw binary input data, an empty to address creates a contract
+// Returns the hex encoded raw signed transaction and the nonce it was signed with
+// The regular tx helpers base64 encode their tx data which is why contract deployments and calls can't use them
+func SignContractTransaction(account *sdkAccounts.Account, shardID uint32, toAddress string, amount numeric.Dec, nonce int, gasLimit uint64, gasPrice numeric.Dec, data []byte) (string, uint64, error) {
+	_, currentNonce, err := TransactionPrerequisites(account, shardID, nonce)
+	if err != nil {
+		return "", 0, err
+	}
+
+	value := amount.Mul(sdkTxs.OneAsDec).TruncateInt()
+	price := gasPrice.Mul(sdkTxs.NanoAsDec).TruncateInt()
+
+	var tx *types.Transaction
+	if toAddress == "" {
+		tx = types.NewContractCreation(currentNonce, shardID, value, gasLimit, price, data)
+	} else {
+		tx = types.NewTransaction(currentNonce, address.Parse(toAddress), shardID, value, gasLimit, price, data)
+	}
+
+	signedTx, err := signers.ForAccount(account).SignTransaction(account, tx, config.Configuration.Network.API.ChainID.Value)
+	if err != nil {
+		return "", 0, err
+	}
+
+	signature, err := sdkTxs.EncodeSignature(signedTx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	txType := "contract_call"
+	if toAddress == "" {
+		txType = "contract_creation"
+	}
+	chainID := config.Configuration.Network.API.ChainID
+	recordRawTransaction(NewRawTransaction(txType, *signature, signedTx.Hash().Hex(), account.Address, toAddress, currentNonce, shardID, shardID, chainID, amount, int64(gasLimit), gasPrice, hexutil.Encode(data)))
+
+	return *signature, currentNonce, nil
+}
+
+// GetCode - retrieves the contract code deployed at a given address in a given shard
+func GetCode(contractAddress string, shardID uint32) ([]byte, error) {
+	rpcClient, err := config.Configuration.Network.API.RPCClient(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := rpcClient.SendRPC("hmy_getCode", []interface{}{contractAddress, "latest"})
+	if err != nil {
+		return nil, err
+	}
+
+	code, ok := reply["result"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve the code for %s in shard %d", contractAddress, shardID)
+	}
+
+	return hexutil.Decode(code)
+}
+
+// Succeeded - whether or not a tx receipt reports a successful execution
+// Receipts without a status are only considered successful if they've been included in a block - SendRawTransaction returns a receipt with just the tx hash if the tx couldn't be confirmed in time
+func Succeeded(receipt map[string]interface{}) bool {
+	switch status := receipt["status"].(type) {
+	case string:
+		return status == "0x1" || status == "1"
+	case float64:
+		return status == 1
+	default:
+		blockNumber, ok := receipt["blockNumber"]
+		return ok && blockNumber != nil
+	}
+}
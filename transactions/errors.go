@@ -0,0 +1,24 @@
+package transactions
+
+import (
+	"errors"
+	"strings"
+)
+
+// IsError - checks if a tx error matches a given target error (i.e. core.ErrUnderpriced)
+// Errors returned by the RPC endpoints or the tx error sink are plain error messages - those have to match the target message exactly,
+// optionally prefixed by the context the node wraps errors with (e.g. "transaction gas is 100000000: exceeds block gas limit")
+// A plain substring match isn't used since e.g. core.ErrUnderpriced would also match core.ErrReplaceUnderpriced
+func IsError(err error, target error) bool {
+	if err == nil || target == nil {
+		return false
+	}
+
+	if errors.Is(err, target) {
+		return true
+	}
+
+	message := err.Error()
+
+	return message == target.Error() || strings.HasSuffix(message, ": "+target.Error())
+}
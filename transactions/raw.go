@@ -0,0 +1,130 @@
+package transactions
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	sdkAccounts "github.com/harmony-one/go-lib/accounts"
+	sdkTxs "github.com/harmony-one/go-lib/transactions"
+	"github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/logger"
+	"github.com/harmony-one/harmony-tf/signers"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+// SignTransaction - generates and signs a transaction using the signer of the account without sending it, returns the hex encoded raw signed transaction
+// A nil chain id will use the chain id of the currently configured network
+func SignTransaction(account *sdkAccounts.Account, chainID *common.ChainID, fromShardID uint32, toAddress string, toShardID uint32, amount numeric.Dec, nonce int, gasLimit int64, gasPrice numeric.Dec, txData string) (string, error) {
+	currentNonce, chainID, encodedTxData, err := signingPrerequisites(account, chainID, fromShardID, nonce, txData)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := sdkTxs.GenerateTransaction(account.Address, fromShardID, toAddress, toShardID, amount, gasLimit, gasPrice, currentNonce, encodedTxData)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := signers.ForAccount(account).SignTransaction(account, tx, chainID.Value)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := sdkTxs.EncodeSignature(signedTx)
+	if err != nil {
+		return "", err
+	}
+
+	recordRawTransaction(NewRawTransaction("transaction", *signature, signedTx.Hash().Hex(), account.Address, toAddress, currentNonce, fromShardID, toShardID, chainID, amount, int64(signedTx.GasLimit()), gasPrice, txData))
+
+	return *signature, nil
+}
+
+// SignEthTransaction - generates and signs an eth transaction using the signer of the account without sending it, returns the hex encoded raw signed transaction
+// A nil chain id will use the chain id of the currently configured network
+func SignEthTransaction(account *sdkAccounts.Account, chainID *common.ChainID, shardID uint32, toAddress string, amount numeric.Dec, nonce int, gasLimit int64, gasPrice numeric.Dec, txData string) (string, error) {
+	currentNonce, chainID, encodedTxData, err := signingPrerequisites(account, chainID, shardID, nonce, txData)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := sdkTxs.GenerateEthTransaction(account.Address, toAddress, amount, gasLimit, gasPrice, currentNonce, encodedTxData)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := signers.ForAccount(account).SignEthTransaction(account, tx, chainID.Value)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := sdkTxs.EncodeSignature(signedTx)
+	if err != nil {
+		return "", err
+	}
+
+	recordRawTransaction(NewRawTransaction("eth_transaction", *signature, signedTx.Hash().Hex(), account.Address, toAddress, currentNonce, shardID, shardID, chainID, amount, int64(signedTx.GasLimit()), gasPrice, txData))
+
+	return *signature, nil
+}
+
+// SendRawTransaction - broadcasts a hex encoded raw signed transaction to a given shard and waits up to timeout seconds for it to finalize
+func SendRawTransaction(signedTx string, shardID uint32, timeout int) (map[string]interface{}, error) {
+	rpcClient, err := config.Configuration.Network.API.RPCClient(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	submittedAt := time.Now().UTC()
+	receiptHash, err := sdkTxs.SendRawTransaction(rpcClient, &signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, _ := receiptHash.(string)
+	TrackSubmission(hash, submittedAt, time.Time{})
+
+	if timeout > 0 && hash != "" {
+		result, err := sdkTxs.WaitForTxConfirmation(rpcClient, config.Configuration.Network.API.NodeAddress(shardID), "transaction", hash, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			TrackSubmission(hash, submittedAt, time.Now().UTC())
+			return result, nil
+		}
+	}
+
+	result := make(map[string]interface{})
+	result["transactionHash"] = hash
+
+	return result, nil
+}
+
+func signingPrerequisites(account *sdkAccounts.Account, chainID *common.ChainID, shardID uint32, nonce int, txData string) (uint64, *common.ChainID, string, error) {
+	_, currentNonce, err := TransactionPrerequisites(account, shardID, nonce)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	if chainID == nil {
+		chainID = config.Configuration.Network.API.ChainID
+	}
+
+	if len(txData) > 0 {
+		txData = base64.StdEncoding.EncodeToString([]byte(txData))
+	}
+
+	return currentNonce, chainID, txData, nil
+}
+
+func recordRawTransaction(rawTx RawTransaction) {
+	TrackTransaction(rawTx)
+
+	if err := RecordRawTransaction(rawTx); err != nil {
+		logger.ErrorLog(fmt.Sprintf("Failed to record raw transaction %s - error: %s", rawTx.TransactionHash, err.Error()), true)
+	}
+}
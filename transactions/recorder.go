@@ -0,0 +1,148 @@
+package transactions
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/utils"
+	"github.com/harmony-one/harmony/numeric"
+)
+
+var (
+	recorderMutex sync.Mutex
+	recorderPath  string
+)
+
+// RawTransaction - represents a recorded raw signed transaction
+type RawTransaction struct {
+	Type            string    `json:"type"`
+	Hex             string    `json:"hex"`
+	TransactionHash string    `json:"transaction_hash"`
+	Sender          string    `json:"sender"`
+	Receiver        string    `json:"receiver"`
+	Nonce           uint64    `json:"nonce"`
+	FromShardID     uint32    `json:"from_shard_id"`
+	ToShardID       uint32    `json:"to_shard_id"`
+	ChainName       string    `json:"chain_name"`
+	ChainID         string    `json:"chain_id"`
+	Amount          string    `json:"amount"`
+	GasLimit        int64     `json:"gas_limit"`
+	GasPrice        string    `json:"gas_price"`
+	Data            string    `json:"data,omitempty"`
+	RecordedAt      time.Time `json:"recorded_at"`
+}
+
+// NewRawTransaction - creates a raw transaction record using the supplied tx values
+func NewRawTransaction(txType string, hex string, txHash string, sender string, receiver string, nonce uint64, fromShardID uint32, toShardID uint32, chainID *common.ChainID, amount numeric.Dec, gasLimit int64, gasPrice numeric.Dec, txData string) RawTransaction {
+	rawTx := RawTransaction{
+		Type:            txType,
+		Hex:             hex,
+		TransactionHash: txHash,
+		Sender:          sender,
+		Receiver:        receiver,
+		Nonce:           nonce,
+		FromShardID:     fromShardID,
+		ToShardID:       toShardID,
+		GasLimit:        gasLimit,
+		Data:            txData,
+		RecordedAt:      time.Now().UTC(),
+	}
+
+	if chainID != nil {
+		rawTx.ChainName = chainID.Name
+		rawTx.ChainID = chainID.Value.String()
+	}
+
+	if !amount.IsNil() {
+		rawTx.Amount = amount.String()
+	}
+
+	if !gasPrice.IsNil() {
+		rawTx.GasPrice = gasPrice.String()
+	}
+
+	return rawTx
+}
+
+// ChainIDValue - converts the recorded chain name and id back to a chain id
+func (rawTx *RawTransaction) ChainIDValue() (*common.ChainID, error) {
+	value, ok := new(big.Int).SetString(rawTx.ChainID, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid chain id %s for the recorded transaction %s", rawTx.ChainID, rawTx.TransactionHash)
+	}
+
+	return &common.ChainID{Name: rawTx.ChainName, Value: value}, nil
+}
+
+// RecordRawTransaction - appends a raw signed transaction to the raw transaction file of the current run (if raw tx recording has been enabled)
+func RecordRawTransaction(rawTx RawTransaction) error {
+	if !config.Configuration.Export.RawTransactions {
+		return nil
+	}
+
+	recorderMutex.Lock()
+	defer recorderMutex.Unlock()
+
+	if recorderPath == "" {
+		fileName := fmt.Sprintf("%s-UTC-raw-transactions.jsonl", utils.FormattedTimeString(config.Configuration.Framework.StartTime))
+		recorderPath = filepath.Join(config.Configuration.Export.Path, fileName)
+	}
+
+	file, err := os.OpenFile(recorderPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	line, err := json.Marshal(rawTx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LoadRawTransactions - loads all recorded raw transactions from a given raw transaction file
+func LoadRawTransactions(path string) (rawTxs []RawTransaction, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var rawTx RawTransaction
+		if err := json.Unmarshal(line, &rawTx); err != nil {
+			return nil, fmt.Errorf("%s:%d: failed to parse raw transaction - error: %s", path, lineNumber, err.Error())
+		}
+		rawTxs = append(rawTxs, rawTx)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rawTxs, nil
+}
@@ -0,0 +1,93 @@
+package transactions
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harmony-one/harmony-tf/config"
+)
+
+var (
+	trackerMutex sync.Mutex
+	tracked      = make(map[string]*TrackedTransaction)
+	trackedOrder []string
+)
+
+// TrackedTransaction - the signing and broadcast details of a transaction sent during the current run, used for the detailed transaction exports
+type TrackedTransaction struct {
+	RawTransaction
+	SubmittedAt time.Time
+	ConfirmedAt time.Time
+}
+
+// TrackTransaction - keeps track of a signed transaction so that its nonce, gas settings and timestamps can be exported
+func TrackTransaction(rawTx RawTransaction) {
+	if !trackingEnabled() {
+		return
+	}
+
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+
+	// the raw hex isn't needed for the exports - don't keep it around for the duration of long runs
+	rawTx.Hex = ""
+	hash := strings.ToLower(rawTx.TransactionHash)
+	if _, ok := tracked[hash]; !ok {
+		trackedOrder = append(trackedOrder, hash)
+	}
+	tracked[hash] = &TrackedTransaction{RawTransaction: rawTx}
+}
+
+// TrackSubmission - records when a tracked transaction was submitted and (if it was) confirmed
+func TrackSubmission(txHash string, submittedAt time.Time, confirmedAt time.Time) {
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+
+	if trackedTx, ok := tracked[strings.ToLower(txHash)]; ok {
+		trackedTx.SubmittedAt = submittedAt
+		trackedTx.ConfirmedAt = confirmedAt
+	}
+}
+
+// TrackedCount - the number of transactions tracked so far
+func TrackedCount() int {
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+
+	return len(trackedOrder)
+}
+
+// TrackedSince - the transactions tracked after the first count transactions, in the order they were signed
+func TrackedSince(count int) (txs []TrackedTransaction) {
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+
+	if count < 0 {
+		count = 0
+	}
+
+	for index := count; index < len(trackedOrder); index++ {
+		txs = append(txs, *tracked[trackedOrder[index]])
+	}
+
+	return txs
+}
+
+// Tracked - the tracked details of a given transaction
+func Tracked(txHash string) (TrackedTransaction, bool) {
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+
+	trackedTx, ok := tracked[strings.ToLower(txHash)]
+	if !ok {
+		return TrackedTransaction{}, false
+	}
+
+	return *trackedTx, true
+}
+
+// trackingEnabled - the tracked details are only used by the transaction exports and the invariant checks, skip tracking when neither is enabled
+func trackingEnabled() bool {
+	return config.Configuration.Export.Transactions != "" || config.Configuration.Invariants.Enabled
+}
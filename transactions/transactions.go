@@ -1,11 +1,8 @@
 package transactions
 
 import (
-	"encoding/base64"
-
 	sdkAccounts "github.com/harmony-one/go-lib/accounts"
 	sdkNetworkNonce "github.com/harmony-one/go-lib/network/rpc/nonces"
-	sdkTxs "github.com/harmony-one/go-lib/transactions"
 	"github.com/harmony-one/go-sdk/pkg/rpc"
 	"github.com/harmony-one/harmony-tf/config"
 	"github.com/harmony-one/harmony/numeric"
@@ -13,24 +10,12 @@ import (
 
 // SendTransaction - send transactions
 func SendTransaction(account *sdkAccounts.Account, fromShardID uint32, toAddress string, toShardID uint32, amount numeric.Dec, nonce int, gasLimit int64, gasPrice numeric.Dec, txData string, timeout int) (map[string]interface{}, error) {
-	account.Unlock()
-
-	rpcClient, currentNonce, err := TransactionPrerequisites(account, fromShardID, nonce)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(txData) > 0 {
-		txData = base64.StdEncoding.EncodeToString([]byte(txData))
-	}
-
-	txResult, err := sdkTxs.SendTransaction(account.Keystore, account.Account, rpcClient, config.Configuration.Network.API.ChainID, account.Address, fromShardID, toAddress, toShardID, amount, gasLimit, gasPrice, currentNonce, txData, config.Configuration.Account.Passphrase, config.Configuration.Network.API.NodeAddress(fromShardID), timeout)
-
+	signedTx, err := SignTransaction(account, nil, fromShardID, toAddress, toShardID, amount, nonce, gasLimit, gasPrice, txData)
 	if err != nil {
 		return nil, err
 	}
 
-	return txResult, nil
+	return SendRawTransaction(signedTx, fromShardID, timeout)
 }
 
 // SendSameShardTransaction - send a transaction using the same shard for both the receiver and the sender
@@ -40,26 +25,12 @@ func SendSameShardTransaction(account *sdkAccounts.Account, toAddress string, sh
 
 // SendEthTransaction - send transactions
 func SendEthTransaction(account *sdkAccounts.Account, shardID uint32, toAddress string, amount numeric.Dec, nonce int, gasLimit int64, gasPrice numeric.Dec, txData string, timeout int) (map[string]interface{}, error) {
-	account.Unlock()
-
-	rpcClient, currentNonce, err := TransactionPrerequisites(account, shardID, nonce)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(txData) > 0 {
-		txData = base64.StdEncoding.EncodeToString([]byte(txData))
-	}
-
-	nodeAddress := config.Configuration.Network.API.NodeAddress(shardID)
-
-	txResult, err := sdkTxs.SendEthTransaction(account.Keystore, account.Account, rpcClient, config.Configuration.Network.API.ChainID, account.Address, toAddress, amount, gasLimit, gasPrice, currentNonce, txData, config.Configuration.Account.Passphrase, nodeAddress, timeout)
-
+	signedTx, err := SignEthTransaction(account, nil, shardID, toAddress, amount, nonce, gasLimit, gasPrice, txData)
 	if err != nil {
 		return nil, err
 	}
 
-	return txResult, nil
+	return SendRawTransaction(signedTx, shardID, timeout)
 }
 
 // TransactionPrerequisites - resolves required clients to perform transactions
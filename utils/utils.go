@@ -3,7 +3,6 @@ package utils
 import (
 	"fmt"
 	"io/ioutil"
-	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -18,18 +17,6 @@ func PrefixAddress(prefix string, address string) string {
 	return fmt.Sprintf("%s_%s", strings.Title(prefix), address)
 }
 
-// NetworkTimeoutAdjustment - adjusts the wait time based on different networks
-func NetworkTimeoutAdjustment(networkName string, currentTimeout int) int {
-	if currentTimeout > 0 {
-		switch networkName {
-		case "localnet", "staking", "stress":
-			currentTimeout = int(math.RoundToEven(float64(currentTimeout) * 1.5))
-		}
-	}
-
-	return currentTimeout
-}
-
 // FileToLines - parse a given text file and split it into a new line delimited slice
 func FileToLines(filePath string) (lines []string, err error) {
 	data, err := ReadFileToString(filePath)
@@ -0,0 +1,318 @@
+// Package validation strictly validates the framework config, the network profiles and the test case files without connecting to the network
+package validation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	goSDKCommon "github.com/harmony-one/go-sdk/pkg/common"
+	"github.com/harmony-one/harmony-tf/config"
+	"github.com/harmony-one/harmony-tf/testing"
+	"github.com/harmony-one/harmony-tf/utils"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// DecimalFields - the keys whose values are parsed as decimals
+	DecimalFields = []string{"amount", "rate", "max_rate", "max_change_rate", "minimum_funds", "threshold", "target", "cost", "price"}
+
+	// ShardFields - the keys whose values are shard ids
+	ShardFields = []string{"from_shard_id", "to_shard_id"}
+)
+
+// Issue - a validation issue found in a given file
+type Issue struct {
+	File    string
+	Line    int
+	Message string
+}
+
+// String - formats the issue as file:line: message
+func (issue Issue) String() string {
+	if issue.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", issue.File, issue.Line, issue.Message)
+	}
+
+	return fmt.Sprintf("%s: %s", issue.File, issue.Message)
+}
+
+// Report - the outcome of a validation run
+type Report struct {
+	Files  []string
+	Issues []Issue
+}
+
+// Validate - validates config.yml, the network profiles and every test case file in a given base path
+// Shard ids are checked against the shard count hint of the network profile of the configured (or given) network
+func Validate(basePath string, network string, scenarios map[string]func(testCase *testing.TestCase)) (report Report) {
+	configPath := filepath.Join(basePath, "config.yml")
+	cfg := config.Config{}
+	report.check(configPath, &cfg)
+
+	if network == "" {
+		network = cfg.Network.Name
+	}
+
+	shards := 0
+	profilesPath := filepath.Join(basePath, "networks")
+	profileFiles, _ := filepath.Glob(filepath.Join(profilesPath, "*.yml"))
+	for _, profileFile := range profileFiles {
+		profile := config.NetworkProfile{Path: profileFile}
+		if report.check(profileFile, &profile) {
+			if err := profile.Initialize(); err != nil {
+				report.Issues = append(report.Issues, Issue{File: profileFile, Message: err.Error()})
+			}
+			if profile.Matches(network) {
+				shards = profile.Shards
+			}
+		}
+	}
+
+	fixtures := testing.FixtureRegistry{}
+	fixturesPath := filepath.Join(basePath, "fixtures.yml")
+	if _, err := os.Stat(fixturesPath); err == nil && report.check(fixturesPath, &fixtures) {
+		report.checkFixtures(fixturesPath, &fixtures, shards)
+	}
+
+	testCaseFiles := []string{}
+	filepath.Walk(filepath.Join(basePath, "testcases"), func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && filepath.Ext(path) == ".yml" {
+			testCaseFiles = append(testCaseFiles, path)
+		}
+		return nil
+	})
+	sort.Strings(testCaseFiles)
+
+	if len(testCaseFiles) == 0 {
+		report.Issues = append(report.Issues, Issue{File: filepath.Join(basePath, "testcases"), Message: "no test case files found"})
+	}
+
+	for _, testCaseFile := range testCaseFiles {
+		start := len(report.Issues)
+
+		testCase := testing.TestCase{}
+		if !report.check(testCaseFile, &testCase) {
+			report.sortFrom(start)
+			continue
+		}
+
+		data, _ := utils.ReadFileToString(testCaseFile)
+		lines := keyLines(data)
+
+		scenario := strings.ToLower(testCase.Scenario)
+		if scenario == "" {
+			report.Issues = append(report.Issues, Issue{File: testCaseFile, Message: "no scenario has been specified"})
+		} else if _, ok := scenarios[scenario]; !ok {
+			report.Issues = append(report.Issues, Issue{File: testCaseFile, Line: lines["scenario"], Message: fmt.Sprintf("unknown scenario %q", testCase.Scenario)})
+		}
+
+		report.checkFixtureReferences(testCaseFile, scenario, testCase.Fixtures, lines, &fixtures)
+		report.checkShards(testCaseFile, data, lines, shards)
+		report.checkMatrix(testCaseFile, data, lines, shards)
+		report.sortFrom(start)
+	}
+
+	return report
+}
+
+// Print - outputs the issues and a summary of the validation run
+func (report *Report) Print() {
+	for _, issue := range report.Issues {
+		fmt.Println(issue.String())
+	}
+
+	fmt.Printf("Validated %d files - found %d issue(s)\n", len(report.Files), len(report.Issues))
+}
+
+// Error - returns an error if any issues were found
+func (report *Report) Error() error {
+	if len(report.Issues) > 0 {
+		return fmt.Errorf("validation failed - found %d issue(s)", len(report.Issues))
+	}
+
+	return nil
+}
+
+// check - strictly parses a file into a given entity and checks the decimal fields, returns whether or not the file could be decoded
+func (report *Report) check(path string, entity interface{}) bool {
+	start := len(report.Issues)
+	defer report.sortFrom(start)
+
+	report.Files = append(report.Files, path)
+
+	if _, err := os.Stat(path); err != nil {
+		report.Issues = append(report.Issues, Issue{File: path, Message: "file doesn't exist or can't be read"})
+		return false
+	}
+
+	data, err := utils.ReadFileToString(path)
+	if err != nil {
+		report.Issues = append(report.Issues, Issue{File: path, Message: err.Error()})
+		return false
+	}
+
+	issues, decoded := parseStrict(path, data, entity)
+	report.Issues = append(report.Issues, issues...)
+
+	if decoded {
+		report.checkDecimals(path, data, keyLines(data))
+	}
+
+	return decoded
+}
+
+// sortFrom - sorts the issues of the file currently being validated by line
+func (report *Report) sortFrom(start int) {
+	issues := report.Issues[start:]
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Line < issues[j].Line })
+}
+
+func (report *Report) checkDecimals(path string, data string, lines map[string]int) {
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(data), &document); err != nil {
+		return
+	}
+
+	walk("", document, func(keyPath string, key string, value interface{}) {
+		if value == nil || !utils.StringSliceContains(DecimalFields, key) {
+			return
+		}
+
+		raw := scalarString(value)
+		if raw == "" {
+			return
+		}
+
+		if _, err := goSDKCommon.NewDecFromString(raw); err != nil {
+			report.Issues = append(report.Issues, Issue{File: path, Line: lines[keyPath], Message: fmt.Sprintf("%s: invalid decimal %q", keyPath, raw)})
+		}
+	})
+}
+
+func (report *Report) checkShards(path string, data string, lines map[string]int, shards int) {
+	if shards <= 0 {
+		return
+	}
+
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(data), &document); err != nil {
+		return
+	}
+
+	walk("", document, func(keyPath string, key string, value interface{}) {
+		if value == nil || !utils.StringSliceContains(ShardFields, key) {
+			return
+		}
+
+		shardID, err := strconv.Atoi(scalarString(value))
+		if err != nil {
+			return
+		}
+
+		if shardID < 0 || shardID >= shards {
+			report.Issues = append(report.Issues, Issue{File: path, Line: lines[keyPath], Message: fmt.Sprintf("%s: shard %d doesn't exist - the network has %d shards (0-%d)", keyPath, shardID, shards, shards-1)})
+		}
+	})
+}
+
+// checkFixtures - checks that every fixture has a unique name and that validator fixtures target an existing shard through a known rpc prefix
+func (report *Report) checkFixtures(path string, fixtures *testing.FixtureRegistry, shards int) {
+	names := []string{}
+	for _, fixture := range fixtures.Validators {
+		names = append(names, fixture.Name)
+
+		if shards > 0 && int(fixture.ShardID) >= shards {
+			report.Issues = append(report.Issues, Issue{File: path, Message: fmt.Sprintf("fixture %s: shard %d doesn't exist - the network has %d shards (0-%d)", fixture.Name, fixture.ShardID, shards, shards-1)})
+		}
+
+		if fixture.RPCPrefix != "" && fixture.RPCPrefix != "hmy" && fixture.RPCPrefix != "eth" {
+			report.Issues = append(report.Issues, Issue{File: path, Message: fmt.Sprintf("fixture %s: unknown rpc prefix %q - valid prefixes: hmy, eth", fixture.Name, fixture.RPCPrefix)})
+		}
+	}
+	for _, fixture := range fixtures.Accounts {
+		names = append(names, fixture.Name)
+	}
+
+	declared := make(map[string]bool)
+	for _, name := range names {
+		switch {
+		case name == "":
+			report.Issues = append(report.Issues, Issue{File: path, Message: "every fixture requires a name"})
+		case declared[name]:
+			report.Issues = append(report.Issues, Issue{File: path, Message: fmt.Sprintf("fixture %s has been declared more than once", name)})
+		}
+		declared[name] = true
+	}
+}
+
+// checkFixtureReferences - checks that the fixtures referenced by a test case have been declared and that validator fixtures aren't used by scenarios changing the validator
+func (report *Report) checkFixtureReferences(path string, scenario string, references testing.FixtureReferences, lines map[string]int, fixtures *testing.FixtureRegistry) {
+	if references.Validator != "" {
+		if _, err := fixtures.ValidatorFixture(references.Validator); err != nil {
+			report.Issues = append(report.Issues, Issue{File: path, Line: lines["fixtures.validator"], Message: fmt.Sprintf("unknown validator fixture %q", references.Validator)})
+		}
+
+		if testing.ChangesValidatorState(scenario) {
+			report.Issues = append(report.Issues, Issue{File: path, Line: lines["fixtures.validator"], Message: fmt.Sprintf("scenario %s changes the state of the validator it uses and can't use the shared validator fixture %q", scenario, references.Validator)})
+		}
+	}
+
+	if references.Delegators != "" {
+		if _, err := fixtures.AccountFixture(references.Delegators); err != nil {
+			report.Issues = append(report.Issues, Issue{File: path, Line: lines["fixtures.delegators"], Message: fmt.Sprintf("unknown account fixture %q", references.Delegators)})
+		}
+	}
+}
+
+// checkMatrix - validates every test case generated from the matrix of a test case file, issues are reported on the lines of the matrix keys
+func (report *Report) checkMatrix(path string, data string, lines map[string]int, shards int) {
+	expansions, err := testing.ExpandMatrix([]byte(data))
+	if err != nil {
+		report.Issues = append(report.Issues, Issue{File: path, Line: lines["matrix"], Message: err.Error()})
+		return
+	}
+
+	if len(expansions) == 1 && expansions[0].Name == "" {
+		return
+	}
+
+	reported := make(map[string]bool)
+	for _, issue := range report.Issues {
+		if issue.File == path {
+			reported[issue.Message] = true
+		}
+	}
+
+	for _, expansion := range expansions {
+		expandedData := string(expansion.Data)
+		expandedLines := make(map[string]int)
+		for key, target := range expansion.Paths {
+			expandedLines[target] = lines[fmt.Sprintf("matrix.%s", key)]
+		}
+
+		expanded := Report{}
+		issues, decoded := parseStrict(path, expandedData, &testing.TestCase{})
+		for _, issue := range issues {
+			// the lines of the strict parsing issues refer to the generated document
+			issue.Line = lines["matrix"]
+			expanded.Issues = append(expanded.Issues, issue)
+		}
+
+		if decoded {
+			expanded.checkDecimals(path, expandedData, expandedLines)
+			expanded.checkShards(path, expandedData, expandedLines, shards)
+		}
+
+		for _, issue := range expanded.Issues {
+			if reported[issue.Message] {
+				continue
+			}
+			reported[issue.Message] = true
+			report.Issues = append(report.Issues, Issue{File: issue.File, Line: issue.Line, Message: fmt.Sprintf("matrix expansion %s: %s", expansion.Name, issue.Message)})
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	lineErrorPattern = regexp.MustCompile(`line (\d+): (.*)`)
+	keyPattern       = regexp.MustCompile(`^("[^"]+"|'[^']+'|[^\s:#"'][^:#]*?)\s*:(\s|$)`)
+)
+
+// parseStrict - strictly parses YAML data into a given entity and converts unknown fields, type errors and syntax errors to issues
+// Returns whether or not the entity could be decoded - unknown fields and type errors don't prevent the remaining fields from being decoded
+func parseStrict(file string, data string, entity interface{}) (issues []Issue, decoded bool) {
+	err := yaml.UnmarshalStrict([]byte(data), entity)
+	if err == nil {
+		return nil, true
+	}
+
+	if typeError, ok := err.(*yaml.TypeError); ok {
+		for _, message := range typeError.Errors {
+			issues = append(issues, lineIssue(file, message))
+		}
+		return issues, true
+	}
+
+	return []Issue{lineIssue(file, strings.TrimPrefix(err.Error(), "yaml: "))}, false
+}
+
+// lineIssue - converts a yaml error message in the format of "line <n>: <message>" to an issue
+func lineIssue(file string, message string) Issue {
+	matches := lineErrorPattern.FindStringSubmatch(message)
+	if len(matches) != 3 {
+		return Issue{File: file, Message: message}
+	}
+
+	line, _ := strconv.Atoi(matches[1])
+
+	return Issue{File: file, Line: line, Message: matches[2]}
+}
+
+type keyLine struct {
+	indent   int
+	name     string
+	sequence bool
+}
+
+// keyLines - maps the key paths (e.g. parameters.gas.price or endpoints.localnet[0]) of a block style YAML document to the lines they're declared on
+func keyLines(data string) map[string]int {
+	lines := make(map[string]int)
+	counters := make(map[string]int)
+	stack := []keyLine{}
+
+	for index, line := range strings.Split(data, "\n") {
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+		content = strings.TrimSpace(content)
+
+		if content == "" || strings.HasPrefix(content, "#") || content == "---" {
+			continue
+		}
+
+		if strings.HasPrefix(content, "- ") || content == "-" {
+			for len(stack) > 0 && (stack[len(stack)-1].indent > indent || (stack[len(stack)-1].indent == indent && stack[len(stack)-1].sequence)) {
+				stack = stack[:len(stack)-1]
+			}
+
+			parent := keyPath(stack)
+			stack = append(stack, keyLine{indent: indent, name: fmt.Sprintf("[%d]", counters[parent]), sequence: true})
+			counters[parent]++
+			lines[keyPath(stack)] = index + 1
+
+			content = strings.TrimSpace(strings.TrimPrefix(content, "-"))
+			indent += 2
+		} else {
+			for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+				stack = stack[:len(stack)-1]
+			}
+		}
+
+		matches := keyPattern.FindStringSubmatch(content)
+		if len(matches) < 2 {
+			continue
+		}
+
+		name := strings.Trim(matches[1], `"'`)
+		stack = append(stack, keyLine{indent: indent, name: name})
+		path := keyPath(stack)
+		lines[path] = index + 1
+		counters[path] = 0
+	}
+
+	return lines
+}
+
+func keyPath(stack []keyLine) string {
+	var builder strings.Builder
+
+	for _, key := range stack {
+		if !key.sequence && builder.Len() > 0 {
+			builder.WriteString(".")
+		}
+		builder.WriteString(key.name)
+	}
+
+	return builder.String()
+}
+
+// walk - calls a given function for every scalar value of a generically parsed YAML document together with its key path
+func walk(path string, value interface{}, fn func(path string, key string, value interface{})) {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		for rawKey, child := range typed {
+			key := fmt.Sprintf("%v", rawKey)
+			childPath := key
+			if path != "" {
+				childPath = fmt.Sprintf("%s.%s", path, key)
+			}
+			walk(childPath, child, fn)
+		}
+	case []interface{}:
+		for index, child := range typed {
+			walk(fmt.Sprintf("%s[%d]", path, index), child, fn)
+		}
+	default:
+		key := path
+		if index := strings.LastIndex(path, "."); index >= 0 {
+			key = path[index+1:]
+		}
+		fn(path, key, value)
+	}
+}
+
+// scalarString - the string representation of a scalar YAML value
+func scalarString(value interface{}) string {
+	switch typed := value.(type) {
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}